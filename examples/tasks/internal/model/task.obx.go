@@ -256,6 +256,14 @@ func (box *TaskBox) GetAll() ([]*Task, error) {
 	return objects.([]*Task), nil
 }
 
+// ForEach reads all stored objects one by one, passing each to fn, as opposed to GetAll() which reads all at once.
+// Return false from fn to stop the iteration early; fn is called inside a read transaction.
+func (box *TaskBox) ForEach(fn func(*Task) (bool, error)) error {
+	return box.Box.ForEach(func(object interface{}) (bool, error) {
+		return fn(object.(*Task))
+	})
+}
+
 // Remove deletes a single object
 func (box *TaskBox) Remove(object *Task) error {
 	return box.Box.Remove(object)
@@ -374,6 +382,14 @@ func (query *TaskQuery) Find() ([]*Task, error) {
 	return objects.([]*Task), nil
 }
 
+// ForEach calls fn for each object matching the query, loading them one by one, as opposed to Find().
+// Return false from fn to stop the iteration early; fn is called inside a read transaction.
+func (query *TaskQuery) ForEach(fn func(*Task) (bool, error)) error {
+	return query.Query.ForEach(func(object interface{}) (bool, error) {
+		return fn(object.(*Task))
+	})
+}
+
 // Offset defines the index of the first object to process (how many objects to skip)
 func (query *TaskQuery) Offset(offset uint64) *TaskQuery {
 	query.Query.Offset(offset)
@@ -22,6 +22,7 @@ package objectbox
 */
 import "C"
 import (
+	"context"
 	"errors"
 	"unsafe"
 )
@@ -52,6 +53,10 @@ type AsyncBox struct {
 // The returned struct must be freed explicitly using the Close() method.
 // It's usually preferable to use Box::Async() which takes care of resource management and doesn't require closing.
 func NewAsyncBox(ob *ObjectBox, entityId TypeId, timeoutMs uint64) (*AsyncBox, error) {
+	if err := ob.checkWritable(); err != nil {
+		return nil, err
+	}
+
 	var async = &AsyncBox{
 		box:    ob.InternalBox(entityId),
 		cOwned: true,
@@ -82,6 +87,10 @@ func (async *AsyncBox) Close() error {
 }
 
 func (async *AsyncBox) put(object interface{}, mode int) (uint64, error) {
+	if err := async.box.ObjectBox.checkWritable(); err != nil {
+		return 0, err
+	}
+
 	entity := async.box.entity
 	idFromObject, err := entity.binding.GetId(object)
 	if err != nil {
@@ -93,6 +102,16 @@ func (async *AsyncBox) put(object interface{}, mode int) (uint64, error) {
 			" relations because it could result in partial inserts/broken relations")
 	}
 
+	if entity.versionProperty != nil {
+		return 0, errors.New("asynchronous Put/Insert/Update is not supported on entities with a version property" +
+			" because the version can't be checked in the same transaction")
+	}
+
+	// the hook can't run in the same transaction as the put, see BeforePutHook
+	if err := async.box.beforePut(object); err != nil {
+		return 0, err
+	}
+
 	id, err := async.box.idForPut(idFromObject)
 	if err != nil {
 		return 0, err
@@ -151,6 +170,10 @@ func (async *AsyncBox) Remove(object interface{}) error {
 
 // RemoveId deletes a single object asynchronously.
 func (async *AsyncBox) RemoveId(id uint64) error {
+	if err := async.box.ObjectBox.checkWritable(); err != nil {
+		return err
+	}
+
 	return cCall(func() C.obx_err {
 		return C.obx_async_remove(async.cAsync, C.obx_id(id))
 	})
@@ -173,3 +196,25 @@ func (async *AsyncBox) AwaitSubmitted() error {
 		return bool(C.obx_store_await_async_submitted(async.box.ObjectBox.store))
 	})
 }
+
+// AwaitCompletionContext is like AwaitCompletion() but returns the context's error as soon as the context is done,
+// e.g. when its deadline is exceeded. The async queue itself isn't affected by the cancellation.
+func (async *AsyncBox) AwaitCompletionContext(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	// not tracked by ObjectBox.Close(), which makes the wait return by closing the store
+	return cCallContext(ctx, nil, async.AwaitCompletion)
+}
+
+// AwaitSubmittedContext is like AwaitSubmitted() but returns the context's error as soon as the context is done,
+// e.g. when its deadline is exceeded. The async queue itself isn't affected by the cancellation.
+func (async *AsyncBox) AwaitSubmittedContext(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	// not tracked by ObjectBox.Close(), which makes the wait return by closing the store
+	return cCallContext(ctx, nil, async.AwaitSubmitted)
+}
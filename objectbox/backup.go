@@ -0,0 +1,83 @@
+/*
+ * Copyright 2018-2025 ObjectBox Ltd. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package objectbox
+
+/*
+#include <stdlib.h>
+#include "objectbox.h"
+*/
+import "C"
+
+import (
+	"errors"
+	"unsafe"
+)
+
+// BackupFlags control how ObjectBox.BackUpToFile() creates backup files; combine multiple flags using bitwise OR.
+// E.g. when you want "deterministic" file content, you can exclude timestamp and salt.
+type BackupFlags uint32
+
+const (
+	// BackupFlagsNone - default behavior
+	BackupFlagsNone BackupFlags = 0
+
+	// BackupFlagsExcludeTimestamp doesn't include a timestamp (time when the backup file is generated) in the file
+	BackupFlagsExcludeTimestamp BackupFlags = C.OBXBackupFlags_ExcludeTimestamp
+
+	// BackupFlagsExcludeSalt doesn't include a random salt in the backup file
+	BackupFlagsExcludeSalt BackupFlags = C.OBXBackupFlags_ExcludeSalt
+)
+
+// BackupRestoreFlags control how Builder.BackupRestore() restores a backup; combine multiple flags using bitwise OR.
+type BackupRestoreFlags uint32
+
+const (
+	// BackupRestoreFlagsOnlyIfEmpty is the default: the backup is only restored if the database doesn't contain data yet
+	BackupRestoreFlagsOnlyIfEmpty BackupRestoreFlags = 0
+
+	// BackupRestoreFlagsOverwriteExistingData overwrites any existing database with the content of the backup file
+	BackupRestoreFlagsOverwriteExistingData BackupRestoreFlags = C.OBXBackupRestoreFlags_OverwriteExistingData
+
+	// BackupRestoreFlagsIgnoreMissingFile opens the store without restoring if the backup file doesn't exist,
+	// instead of failing. Note: this flag is handled by ObjectBox Go and is not passed to the native library.
+	BackupRestoreFlagsIgnoreMissingFile BackupRestoreFlags = 1 << 31
+)
+
+// BackupIsAvailable returns true if the loaded ObjectBox native library supports backups.
+// Note: backup is a server-only feature.
+func BackupIsAvailable() bool {
+	return bool(C.obx_has_feature(C.OBXFeature_Backup))
+}
+
+// errBackupNotAvailable is returned by backup functions if the loaded library doesn't support them
+var errBackupNotAvailable = errors.New("backup is not available in the loaded ObjectBox library")
+
+// BackUpToFile creates a consistent backup of the (open) store in the given file.
+// Writes may continue while the backup is taken; they're just not included in the backup.
+// Use Builder.BackupRestore() to restore the backup when opening a store.
+func (ob *ObjectBox) BackUpToFile(path string, flags BackupFlags) error {
+	if !BackupIsAvailable() {
+		return errBackupNotAvailable
+	}
+
+	var cPath = C.CString(path)
+	defer C.free(unsafe.Pointer(cPath))
+
+	return cCall(func() C.obx_err {
+		return C.obx_store_back_up_to_file(ob.store, cPath, C.uint32_t(flags))
+	})
+}
@@ -23,7 +23,7 @@ package objectbox
 import "C"
 
 import (
-	"errors"
+	"context"
 	"fmt"
 	"reflect"
 	"runtime"
@@ -38,6 +38,7 @@ type Box struct {
 	entity    *entity
 	cBox      *C.OBX_box
 	async     *AsyncBox
+	tx        *Tx // optional, see Tx.Box()
 }
 
 const defaultSliceCapacity = 16
@@ -48,6 +49,17 @@ func newBox(ob *ObjectBox, entityId TypeId) (*Box, error) {
 		entity:    ob.getEntityById(entityId),
 	}
 
+	// entities without generated code (e.g. NewModelFromJSON()) use the same objects as DynamicBox, i.e. maps
+	if box.entity.binding == nil && box.entity.schema != nil {
+		binding, err := newDynamicBinding(box.entity.schema)
+		if err != nil {
+			return nil, err
+		}
+		var dynamicEntity = *box.entity
+		dynamicEntity.binding = binding
+		box.entity = &dynamicEntity
+	}
+
 	if err := cCallBool(func() bool {
 		box.cBox = C.obx_box(ob.store, C.obx_schema_id(entityId))
 		return box.cBox != nil
@@ -60,6 +72,12 @@ func newBox(ob *ObjectBox, entityId TypeId) (*Box, error) {
 		box:    box,
 		cOwned: false,
 	}
+
+	// there's no async queue in read-only mode; AsyncBox operations fail with ErrReadOnly instead
+	if ob.options.readOnly {
+		return box, nil
+	}
+
 	if err := cCallBool(func() bool {
 		box.async.cAsync = C.obx_async(box.cBox)
 		return box.async.cAsync != nil
@@ -108,6 +126,28 @@ func (box *Box) QueryOrError(conditions ...Condition) (query *Query, err error)
 	return // NOTE result might be overwritten by the deferred "closer" function
 }
 
+// checkWritable verifies write operations are allowed, see ObjectBox.checkWritable() and Tx.Box()
+func (box *Box) checkWritable() error {
+	if err := box.ObjectBox.checkWritable(); err != nil {
+		return err
+	}
+	return box.checkTx(true)
+}
+
+// checkTx verifies the box is used inside its transaction, in case it's bound to one using Tx.Box()
+func (box *Box) checkTx(write bool) error {
+	if box.tx == nil {
+		return nil
+	}
+	if err := box.tx.check(); err != nil {
+		return err
+	}
+	if write && box.tx.readOnly {
+		return errTxReadOnly
+	}
+	return nil
+}
+
 func (box *Box) idForPut(idCandidate uint64) (id uint64, err error) {
 	id = uint64(C.obx_box_id_for_put(box.cBox, C.obx_id(idCandidate)))
 
@@ -142,6 +182,10 @@ func (box *Box) idsForPut(count int) (firstId uint64, err error) {
 }
 
 func (box *Box) put(object interface{}, alreadyInTx bool, putMode C.OBXPutMode) (id uint64, err error) {
+	if err = box.checkWritable(); err != nil {
+		return 0, err
+	}
+
 	idFromObject, err := box.entity.binding.GetId(object)
 	if err != nil {
 		return 0, err
@@ -150,7 +194,8 @@ func (box *Box) put(object interface{}, alreadyInTx bool, putMode C.OBXPutMode)
 	if putMode == cPutModeUpdate {
 		id = idFromObject
 		if idFromObject == 0 {
-			return 0, errors.New("cannot update an object with ID 0 - if it's a new object use Put or Insert instead")
+			return 0, &Error{Code: C.OBX_ERROR_ILLEGAL_ARGUMENT,
+				Message: "cannot update an object with ID 0 - if it's a new object use Put or Insert instead"}
 		}
 	} else {
 		id, err = box.idForPut(idFromObject)
@@ -159,13 +204,36 @@ func (box *Box) put(object interface{}, alreadyInTx bool, putMode C.OBXPutMode)
 		}
 	}
 
-	// for entities with relations, execute all Put/PutRelated inside a single transaction
-	if box.entity.hasRelations && !alreadyInTx {
-		err = box.ObjectBox.RunInWriteTx(func() error {
-			return box.putOne(id, object, putMode)
-		})
+	var hasHooks = box.hasPutHooks(object)
+
+	var putFn = func() error {
+		if hasHooks {
+			if err := box.beforePut(object); err != nil {
+				return err
+			}
+		}
+
+		// with a version property, the stored version must be checked in the same transaction as the put
+		if box.entity.versionProperty != nil {
+			restore, err := box.checkVersion(object, id)
+			if err != nil {
+				return err
+			}
+			if err = box.putOneWithHooks(id, idFromObject, object, putMode, hasHooks); err != nil {
+				restore()
+			}
+			return err
+		}
+
+		return box.putOneWithHooks(id, idFromObject, object, putMode, hasHooks)
+	}
+
+	// for entities with relations, execute all Put/PutRelated inside a single transaction; the same applies to
+	// version checks and hooks
+	if (box.entity.hasRelations || box.entity.versionProperty != nil || hasHooks) && !alreadyInTx {
+		err = box.ObjectBox.RunInWriteTx(putFn)
 	} else {
-		err = box.putOne(id, object, putMode)
+		err = putFn()
 	}
 
 	// update the id on the object
@@ -180,6 +248,27 @@ func (box *Box) put(object interface{}, alreadyInTx bool, putMode C.OBXPutMode)
 	return id, err
 }
 
+// putOneWithHooks calls putOne() and, if hasHooks, the after-put hooks; the ID is set on the object for the hooks
+// and reverted if they fail.
+func (box *Box) putOneWithHooks(id, idFromObject uint64, object interface{}, putMode C.OBXPutMode,
+	hasHooks bool) error {
+	if err := box.putOne(id, object, putMode); err != nil || !hasHooks {
+		return err
+	}
+
+	if idFromObject != id {
+		if err := box.entity.binding.SetId(object, id); err != nil {
+			return err
+		}
+	}
+
+	var err = box.afterPut(object, id)
+	if err != nil && idFromObject != id {
+		_ = box.entity.binding.SetId(object, idFromObject)
+	}
+	return err
+}
+
 func (box *Box) putOne(id uint64, object interface{}, putMode C.OBXPutMode) error {
 	if box.entity.hasRelations { // In that case, the caller already ensured to be inside a TX
 		if err := box.entity.binding.PutRelated(box.ObjectBox, object, id); err != nil {
@@ -188,12 +277,128 @@ func (box *Box) putOne(id uint64, object interface{}, putMode C.OBXPutMode) erro
 	}
 
 	return box.withObjectBytes(object, id, func(bytes []byte) error {
-		return cCall(func() C.obx_err {
+		var err = cCall(func() C.obx_err {
 			return C.obx_box_put5(box.cBox, C.obx_id(id), unsafe.Pointer(&bytes[0]), C.size_t(len(bytes)), putMode)
 		})
+		if err != nil {
+			return box.uniqueViolationDetails(err, []uint64{id}, [][]byte{bytes})
+		}
+		return nil
 	})
 }
 
+// uniqueViolationDetails turns ErrUniqueViolation errors into *UniqueViolationError by checking the unique properties
+// of the given objects (FlatBuffers data) for a value already used by another object, either an existing one or one
+// of the given objects. Other errors, or violations that can't be located, are returned as-is.
+func (box *Box) uniqueViolationDetails(err error, ids []uint64, objectsBytes [][]byte) error {
+	var cause, ok = err.(*Error)
+	if !ok || cause.Code != C.OBX_ERROR_UNIQUE_VIOLATED || box.entity.schema == nil {
+		return err
+	}
+
+	var schema = box.entity.schema
+	var reader = &dynamicBinding{schema: schema, idProperty: schema.IdProperty()}
+
+	// unique values of the given objects seen so far, to detect duplicates within the same batch
+	type uniqueValue struct {
+		property string
+		value    interface{}
+	}
+	var seen = make(map[uniqueValue]bool)
+
+	for i, bytes := range objectsBytes {
+		var table = &flatbuffers.Table{
+			Bytes: bytes,
+			Pos:   flatbuffers.GetUOffsetT(bytes),
+		}
+
+		for _, property := range schema.Properties {
+			if !property.HasFlag(PropertyFlagUnique) {
+				continue
+			}
+
+			var value = reader.getSlot(table, property)
+			if value == nil {
+				continue
+			}
+
+			if existingId := box.findUniqueValue(property, value, ids[i]); existingId != 0 {
+				return &UniqueViolationError{
+					Cause:      cause,
+					Entity:     schema.Name,
+					Property:   property.Name,
+					Value:      value,
+					ExistingId: existingId,
+				}
+			}
+
+			// byte vectors aren't comparable, use a string with the same contents as the map key instead
+			var key = uniqueValue{property: property.Name, value: value}
+			if bytesValue, isBytes := value.([]byte); isBytes {
+				key.value = string(bytesValue)
+			}
+
+			// the other object is part of the same batch, i.e. it isn't stored, thus there's no ExistingId to report
+			if seen[key] {
+				return &UniqueViolationError{Cause: cause, Entity: schema.Name, Property: property.Name, Value: value}
+			}
+			seen[key] = true
+		}
+	}
+
+	return err
+}
+
+// findUniqueValue returns the ID of another object with the given property value or 0 if there's none (or on error)
+func (box *Box) findUniqueValue(property *SchemaProperty, value interface{}, excludeId uint64) uint64 {
+	var base = &BaseProperty{Id: property.Id, Entity: &Entity{Id: box.entity.id}}
+
+	var condition Condition
+	switch v := value.(type) {
+	case string:
+		condition = PropertyString{BaseProperty: base}.Equals(v, true)
+	case []byte:
+		condition = PropertyByteVector{BaseProperty: base}.Equals(v)
+	case bool:
+		condition = PropertyBool{BaseProperty: base}.Equals(v)
+	case int64:
+		condition = PropertyInt64{BaseProperty: base}.Equals(v)
+	case int32:
+		condition = PropertyInt64{BaseProperty: base}.Equals(int64(v))
+	case int16:
+		condition = PropertyInt64{BaseProperty: base}.Equals(int64(v))
+	case int8:
+		condition = PropertyInt64{BaseProperty: base}.Equals(int64(v))
+	case uint64:
+		condition = PropertyUint64{BaseProperty: base}.Equals(v)
+	case uint32:
+		condition = PropertyUint64{BaseProperty: base}.Equals(uint64(v))
+	case uint16:
+		condition = PropertyUint64{BaseProperty: base}.Equals(uint64(v))
+	case uint8:
+		condition = PropertyUint64{BaseProperty: base}.Equals(uint64(v))
+	default: // e.g. floating point numbers can't be compared for equality
+		return 0
+	}
+
+	query, err := box.QueryOrError(condition)
+	if err != nil {
+		return 0
+	}
+	defer query.Close()
+
+	ids, err := query.FindIds()
+	if err != nil {
+		return 0
+	}
+	for _, id := range ids {
+		if id != excludeId {
+			return id
+		}
+	}
+	return 0
+}
+
 func (box *Box) withObjectBytes(object interface{}, id uint64, fn func([]byte) error) error {
 	var fbb = fbbPool.Get().(*flatbuffers.Builder)
 
@@ -252,6 +457,24 @@ func (box *Box) Update(object interface{}) error {
 //
 // Note: The slice may be empty or even nil; in both cases, an empty IDs slice and no error is returned.
 func (box *Box) PutMany(objects interface{}) (ids []uint64, err error) {
+	return box.putMany(nil, objects)
+}
+
+// PutManyContext is like PutMany() but checks the context between chunks of objects (and before committing).
+// If the context is done, the transaction is rolled back and the context's error is returned.
+func (box *Box) PutManyContext(ctx context.Context, objects interface{}) (ids []uint64, err error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return box.putMany(ctx, objects)
+}
+
+// putMany implements PutMany() and PutManyContext(); ctx is optional (may be nil)
+func (box *Box) putMany(ctx context.Context, objects interface{}) (ids []uint64, err error) {
+	if err := box.checkWritable(); err != nil {
+		return nil, err
+	}
+
 	var slice = reflect.ValueOf(objects)
 	var count = slice.Len()
 
@@ -263,6 +486,9 @@ func (box *Box) PutMany(objects interface{}) (ids []uint64, err error) {
 	// prepare the result, filled in below
 	ids = make([]uint64, count)
 
+	// version changes on the objects are reverted if the transaction fails, see Model.VersionProperty()
+	var versionRestores []func()
+
 	// Execute everything in a single single transaction - for performance and consistency.
 	// This is necessary even if count < chunkSize because of relations (PutRelated)
 	err = box.ObjectBox.RunInWriteTx(func() error {
@@ -276,18 +502,30 @@ func (box *Box) PutMany(objects interface{}) (ids []uint64, err error) {
 			}
 
 			for c := 0; c < chunks; c++ {
+				if ctx != nil {
+					if err := ctx.Err(); err != nil {
+						return err
+					}
+				}
+
 				var start = c * chunkSize
 				var end = start + chunkSize
 				if end > count {
 					end = count
 				}
 
-				if err := box.putManyObjects(slice, ids, start, end); err != nil {
+				if err := box.putManyObjects(slice, ids, start, end, &versionRestores); err != nil {
 					return err
 				}
 			}
 		} else {
 			for i := 0; i < count; i++ {
+				if ctx != nil {
+					if err := ctx.Err(); err != nil {
+						return err
+					}
+				}
+
 				id, err := box.put(slice.Index(i).Interface(), true, cPutModePut)
 				if err != nil {
 					return err
@@ -296,20 +534,231 @@ func (box *Box) PutMany(objects interface{}) (ids []uint64, err error) {
 			}
 		}
 
+		// don't commit if the context was cancelled while putting the last chunk
+		if ctx != nil {
+			return ctx.Err()
+		}
 		return nil
 	})
 
 	if err != nil {
+		restoreVersions(versionRestores)
 		ids = nil
 	}
 
 	return ids, err
 }
 
+// InsertMany inserts multiple objects in a single transaction, with the same semantics as Insert() for each object.
+// Objects with an ID that already exists are skipped while all other objects are inserted; the skipped ones are
+// reported by the returned *PutManyError, each failure matching ErrIdAlreadyExists.
+// New objects (ID 0) are assigned an ID, same as with PutMany().
+//
+// Returns: IDs of the objects (in the same order), 0 for objects that were skipped.
+func (box *Box) InsertMany(objects interface{}) (ids []uint64, err error) {
+	return box.putManyWithMode(objects, cPutModeInsert)
+}
+
+// UpdateMany updates multiple objects in a single transaction, with the same semantics as Update() for each object.
+// Objects that don't exist (or have ID 0) are skipped while all other objects are updated; the skipped ones are
+// reported by the returned *PutManyError, each failure matching ErrNotFound (or ErrIllegalArgument for ID 0).
+func (box *Box) UpdateMany(objects interface{}) error {
+	_, err := box.putManyWithMode(objects, cPutModeUpdate)
+	return err
+}
+
+// putManyWithMode implements InsertMany() and UpdateMany()
+func (box *Box) putManyWithMode(objects interface{}, putMode C.OBXPutMode) (ids []uint64, err error) {
+	if err := box.checkWritable(); err != nil {
+		return nil, err
+	}
+
+	var slice = reflect.ValueOf(objects)
+	var count = slice.Len()
+
+	if count == 0 {
+		return []uint64{}, nil
+	}
+
+	ids = make([]uint64, count)
+	var failures = make(map[int]error)
+	var versionRestores []func()
+
+	err = box.ObjectBox.RunInWriteTx(func() error {
+		// the same chunk size as in PutMany() - see there
+		const chunkSize = 10000
+		for start := 0; start < count; start += chunkSize {
+			var end = start + chunkSize
+			if end > count {
+				end = count
+			}
+
+			if err := box.putManyObjectsWithMode(slice, ids, start, end, putMode, failures, &versionRestores); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+
+	if err != nil {
+		restoreVersions(versionRestores)
+		return nil, err
+	} else if len(failures) > 0 {
+		return ids, &PutManyError{Failures: failures}
+	}
+	return ids, nil
+}
+
+// putManyObjectsWithMode is like putManyObjects() but skips objects that would fail with the given put mode,
+// recording them in `failures` instead. Requires to be called inside a write transaction.
+func (box *Box) putManyObjectsWithMode(objects reflect.Value, outIds []uint64, start, end int, putMode C.OBXPutMode,
+	failures map[int]error, versionRestores *[]func()) error {
+	var binding = box.entity.binding
+
+	// checkVersion reports version conflicts as failures, same as the put mode checks; returns false if skipped
+	var checkVersion = func(index int, id uint64) (bool, error) {
+		if box.entity.versionProperty == nil {
+			return true, nil
+		}
+		restore, err := box.checkVersion(objects.Index(index).Interface(), id)
+		if _, isConflict := err.(*VersionConflictError); isConflict {
+			failures[index] = err
+			return false, nil
+		} else if err != nil {
+			return false, err
+		}
+		*versionRestores = append(*versionRestores, restore)
+		return true, nil
+	}
+
+	// indexes of the objects to put and, among those, of new objects (zero IDs) in the `outIds` slice
+	var indexes = make([]int, 0, end-start)
+	var indexesNewObjects = make([]int, 0)
+
+	// IDs already used in this chunk, to detect duplicates in the given objects when inserting
+	var chunkIds = make(map[uint64]bool)
+
+	for index := start; index < end; index++ {
+		id, err := binding.GetId(objects.Index(index).Interface())
+		if err != nil {
+			return err
+		}
+
+		if id == 0 {
+			if putMode == cPutModeUpdate {
+				failures[index] = &Error{Code: C.OBX_ERROR_ILLEGAL_ARGUMENT,
+					Message: "cannot update an object with ID 0 - if it's a new object use Put or Insert instead"}
+				continue
+			}
+			if ok, err := checkVersion(index, 0); err != nil {
+				return err
+			} else if !ok {
+				continue
+			}
+			indexesNewObjects = append(indexesNewObjects, index)
+			indexes = append(indexes, index)
+			continue
+		}
+
+		var exists C.bool
+		if err := cCall(func() C.obx_err { return C.obx_box_contains(box.cBox, C.obx_id(id), &exists) }); err != nil {
+			return err
+		}
+
+		if putMode == cPutModeInsert && (bool(exists) || chunkIds[id]) {
+			failures[index] = &Error{Code: C.OBX_ERROR_ID_ALREADY_EXISTS,
+				Message: fmt.Sprintf("cannot insert an object with ID %d - it already exists", id)}
+			continue
+		} else if putMode == cPutModeUpdate && !bool(exists) {
+			failures[index] = &Error{Code: C.OBX_ERROR_ID_NOT_FOUND,
+				Message: fmt.Sprintf("cannot update an object with ID %d - it doesn't exist", id)}
+			continue
+		}
+
+		if ok, err := checkVersion(index, id); err != nil {
+			return err
+		} else if !ok {
+			continue
+		}
+
+		chunkIds[id] = true
+		outIds[index] = id
+		indexes = append(indexes, index)
+	}
+
+	if len(indexes) == 0 {
+		return nil
+	}
+
+	// if there are any new objects, reserve IDs for them
+	firstNewId, err := box.idsForPut(len(indexesNewObjects))
+	if err != nil {
+		return err
+	}
+	for i := 0; i < len(indexesNewObjects); i++ {
+		outIds[indexesNewObjects[i]] = firstNewId + uint64(i)
+	}
+
+	// flatten all the objects to put
+	var ids = make([]uint64, len(indexes))
+	var objectsBytes = make([][]byte, len(indexes))
+	for i, index := range indexes {
+		var object = objects.Index(index).Interface()
+		ids[i] = outIds[index]
+
+		if err := box.beforePut(object); err != nil {
+			return err
+		}
+
+		if box.entity.hasRelations {
+			if err := binding.PutRelated(box.ObjectBox, object, ids[i]); err != nil {
+				return err
+			}
+		}
+
+		if err := box.withObjectBytes(object, ids[i], func(bytes []byte) error {
+			objectsBytes[i] = make([]byte, len(bytes))
+			copy(objectsBytes[i], bytes)
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+
+	bytesArray, err := goBytesArrayToC(objectsBytes)
+	if err != nil {
+		return err
+	}
+	defer bytesArray.free()
+
+	// ID failures were already filtered out above so any remaining failure aborts the transaction
+	if err := cCall(func() C.obx_err {
+		return C.obx_box_put_many5(box.cBox, bytesArray.cBytesArray, goUint64ArrayToCObxId(ids), putMode, C.bool(true))
+	}); err != nil {
+		return box.uniqueViolationDetails(err, ids, objectsBytes)
+	}
+
+	// set IDs on the new objects
+	for _, index := range indexesNewObjects {
+		if err := binding.SetId(objects.Index(index).Interface(), outIds[index]); err != nil {
+			return fmt.Errorf("setting ID on objects[%v] failed: %s", index, err)
+		}
+	}
+
+	for _, index := range indexes {
+		if err := box.afterPut(objects.Index(index).Interface(), outIds[index]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // putManyObjects inserts a subset of objects, setting their IDs as an outArgument.
 // Requires to be called inside a write transaction, i.e. from the ObjectBox.RunInWriteTx() callback.
 // The caller of this method (PutMany) already sliced up the data into chunks to mitigate memory consumption.
-func (box *Box) putManyObjects(objects reflect.Value, outIds []uint64, start, end int) error {
+func (box *Box) putManyObjects(objects reflect.Value, outIds []uint64, start, end int,
+	versionRestores *[]func()) error {
 	var binding = box.entity.binding
 	var count = end - start
 
@@ -348,6 +797,19 @@ func (box *Box) putManyObjects(objects reflect.Value, outIds []uint64, start, en
 		var key = start + i
 		var object = objects.Index(key).Interface()
 
+		if err := box.beforePut(object); err != nil {
+			return err
+		}
+
+		// check and increment the version, see Model.VersionProperty()
+		if box.entity.versionProperty != nil {
+			restore, err := box.checkVersion(object, outIds[key])
+			if err != nil {
+				return err
+			}
+			*versionRestores = append(*versionRestores, restore)
+		}
+
 		// put related entities for the single object
 		if box.entity.hasRelations {
 			if err := binding.PutRelated(box.ObjectBox, object, outIds[key]); err != nil {
@@ -378,7 +840,7 @@ func (box *Box) putManyObjects(objects reflect.Value, outIds []uint64, start, en
 	if err := cCall(func() C.obx_err {
 		return C.obx_box_put_many(box.cBox, bytesArray.cBytesArray, idsArray, C.OBXPutMode(putMode))
 	}); err != nil {
-		return err
+		return box.uniqueViolationDetails(err, outIds[start:end], objectsBytes)
 	}
 
 	// set IDs on the new objects
@@ -388,6 +850,12 @@ func (box *Box) putManyObjects(objects reflect.Value, outIds []uint64, start, en
 		}
 	}
 
+	for i := 0; i < count; i++ {
+		if err := box.afterPut(objects.Index(start+i).Interface(), outIds[start+i]); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -398,14 +866,41 @@ func (box *Box) Remove(object interface{}) error {
 		return err
 	}
 
-	return box.RemoveId(id)
+	return box.removeId(id, object)
 }
 
 // RemoveId deletes a single object
 func (box *Box) RemoveId(id uint64) error {
-	return cCall(func() C.obx_err {
-		return C.obx_box_remove(box.cBox, C.obx_id(id))
-	})
+	return box.removeId(id, nil)
+}
+
+// removeId implements Remove() and RemoveId(); object is optional (may be nil), it's only passed to the hooks
+func (box *Box) removeId(id uint64, object interface{}) error {
+	if err := box.checkWritable(); err != nil {
+		return err
+	}
+
+	var removeFn = func() error {
+		return cCall(func() C.obx_err {
+			return C.obx_box_remove(box.cBox, C.obx_id(id))
+		})
+	}
+
+	// execute the hooks and delete policies in the same transaction, see BeforeRemoveHook and DeletePolicy
+	var _, objectHook = object.(BeforeRemoveHook)
+	if objectHook || box.hasRemoveHooks() || len(box.entity.deletePolicies) > 0 {
+		return box.ObjectBox.RunInWriteTx(func() error {
+			if err := box.beforeRemove(id, object); err != nil {
+				return err
+			}
+			if err := box.applyDeletePolicies([]uint64{id}, removal{}); err != nil {
+				return err
+			}
+			return removeFn()
+		})
+	}
+
+	return removeFn()
 }
 
 // RemoveIds deletes multiple objects at once.
@@ -414,25 +909,79 @@ func (box *Box) RemoveId(id uint64) error {
 // In case you need to strictly check whether all of the objects exist before removing them,
 // you can execute multiple box.Contains() and box.Remove() inside a single write transaction.
 func (box *Box) RemoveIds(ids ...uint64) (uint64, error) {
+	if err := box.checkWritable(); err != nil {
+		return 0, err
+	}
+
 	cIds, err := goIdsArrayToC(ids)
 	if err != nil {
 		return 0, err
 	}
 
+	defer cIds.free()
+
 	var cResult C.uint64_t
-	err = cCall(func() C.obx_err {
-		defer cIds.free()
-		return C.obx_box_remove_many(box.cBox, cIds.cArray, &cResult)
-	})
+	var removeFn = func() error {
+		return cCall(func() C.obx_err {
+			return C.obx_box_remove_many(box.cBox, cIds.cArray, &cResult)
+		})
+	}
+
+	// execute the hooks and delete policies in the same transaction, see BindingBeforeRemoveHook and DeletePolicy
+	if box.hasRemoveHooks() || len(box.entity.deletePolicies) > 0 {
+		err = box.ObjectBox.RunInWriteTx(func() error {
+			for _, id := range ids {
+				if err := box.beforeRemove(id, nil); err != nil {
+					return err
+				}
+			}
+			if err := box.applyDeletePolicies(ids, removal{}); err != nil {
+				return err
+			}
+			return removeFn()
+		})
+	} else {
+		err = removeFn()
+	}
+
 	return uint64(cResult), err
 }
 
 // RemoveAll removes all stored objects.
 // This is much faster than removing objects one by one in a loop.
 func (box *Box) RemoveAll() error {
-	return cCall(func() C.obx_err {
-		return C.obx_box_remove_all(box.cBox, nil)
-	})
+	if err := box.checkWritable(); err != nil {
+		return err
+	}
+
+	var removeFn = func() error {
+		return cCall(func() C.obx_err {
+			return C.obx_box_remove_all(box.cBox, nil)
+		})
+	}
+
+	// apply delete policies in the same transaction, see DeletePolicy
+	if len(box.entity.deletePolicies) > 0 {
+		return box.ObjectBox.RunInWriteTx(func() error {
+			query, err := box.QueryOrError()
+			if err != nil {
+				return err
+			}
+			defer query.Close()
+
+			ids, err := query.FindIds()
+			if err != nil {
+				return err
+			}
+
+			if err := box.applyDeletePolicies(ids, removal{}); err != nil {
+				return err
+			}
+			return removeFn()
+		})
+	}
+
+	return removeFn()
 }
 
 // Count returns a number of objects stored
@@ -443,6 +992,10 @@ func (box *Box) Count() (uint64, error) {
 // CountMax returns a number of objects stored (up to a given maximum)
 // passing limit=0 is the same as calling Count() - counts all objects without a limit
 func (box *Box) CountMax(limit uint64) (uint64, error) {
+	if err := box.checkTx(false); err != nil {
+		return 0, err
+	}
+
 	var cResult C.uint64_t
 	if err := cCall(func() C.obx_err { return C.obx_box_count(box.cBox, C.uint64_t(limit), &cResult) }); err != nil {
 		return 0, err
@@ -452,6 +1005,10 @@ func (box *Box) CountMax(limit uint64) (uint64, error) {
 
 // IsEmpty checks whether the box contains any objects
 func (box *Box) IsEmpty() (bool, error) {
+	if err := box.checkTx(false); err != nil {
+		return false, err
+	}
+
 	var cResult C.bool
 	if err := cCall(func() C.obx_err { return C.obx_box_is_empty(box.cBox, &cResult) }); err != nil {
 		return false, err
@@ -465,6 +1022,10 @@ func (box *Box) IsEmpty() (bool, error) {
 // Returns nil in case the object with the given ID doesn't exist.
 // The cast is done automatically when using the generated BoxFor* code.
 func (box *Box) Get(id uint64) (object interface{}, err error) {
+	if err := box.checkTx(false); err != nil {
+		return nil, err
+	}
+
 	// we need a read-transaction to keep the data in dataPtr untouched (by concurrent write) until we can read it
 	// as well as making sure the relations read in binding.Load represent a consistent state
 	err = box.ObjectBox.RunInReadTx(func() error {
@@ -476,7 +1037,7 @@ func (box *Box) Get(id uint64) (object interface{}, err error) {
 		if rc == 0 {
 			var bytes []byte
 			cVoidPtrToByteSlice(dataPtr, int(dataSize), &bytes)
-			object, err = box.entity.binding.Load(box.ObjectBox, bytes)
+			object, err = box.load(bytes)
 			return err
 		} else if rc == C.OBX_NOT_FOUND {
 			object = nil
@@ -499,6 +1060,10 @@ func (box *Box) Get(id uint64) (object interface{}, err error) {
 // If any of the objects doesn't exist, its position in the return slice
 //  is nil or an empty object (depends on the binding)
 func (box *Box) GetMany(ids ...uint64) (slice interface{}, err error) {
+	if err := box.checkTx(false); err != nil {
+		return nil, err
+	}
+
 	const existingOnly = false
 	if cIds, err := goIdsArrayToC(ids); err != nil {
 		return nil, err
@@ -510,8 +1075,40 @@ func (box *Box) GetMany(ids ...uint64) (slice interface{}, err error) {
 			defer cIds.free()
 			return C.obx_box_visit_many(box.cBox, cIds.cArray, dataVisitor, visitorArg)
 		}
-		return box.readUsingVisitor(existingOnly, cFn)
+		return box.readUsingVisitor(nil, existingOnly, cFn)
+	}
+}
+
+// GetContext is like Get() but returns the context's error, without reading the object, if the context is done.
+// Note: reading a single object isn't interruptible, the context is only checked upfront.
+func (box *Box) GetContext(ctx context.Context, id uint64) (object interface{}, err error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
 	}
+	return box.Get(id)
+}
+
+// GetManyContext is like GetMany() but stops reading as soon as the context is done, returning the context's error.
+func (box *Box) GetManyContext(ctx context.Context, ids ...uint64) (slice interface{}, err error) {
+	if err := box.checkTx(false); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	// always use the visitor so that the context can be checked after each object
+	const existingOnly = false
+	cIds, err := goIdsArrayToC(ids)
+	if err != nil {
+		return nil, err
+	}
+	var cFn = func(visitorArg unsafe.Pointer) C.obx_err {
+		defer cIds.free()
+		return C.obx_box_visit_many(box.cBox, cIds.cArray, dataVisitor, visitorArg)
+	}
+	return box.readUsingVisitor(ctx, existingOnly, cFn)
 }
 
 // GetManyExisting reads multiple objects at once, skipping those that do not exist.
@@ -519,6 +1116,10 @@ func (box *Box) GetMany(ids ...uint64) (slice interface{}, err error) {
 // Returns a slice of objects that should be cast to the appropriate type.
 // The cast is done automatically when using the generated BoxFor* code.
 func (box *Box) GetManyExisting(ids ...uint64) (slice interface{}, err error) {
+	if err := box.checkTx(false); err != nil {
+		return nil, err
+	}
+
 	const existingOnly = true
 	if cIds, err := goIdsArrayToC(ids); err != nil {
 		return nil, err
@@ -530,7 +1131,7 @@ func (box *Box) GetManyExisting(ids ...uint64) (slice interface{}, err error) {
 			defer cIds.free()
 			return C.obx_box_visit_many(box.cBox, cIds.cArray, dataVisitor, visitorArg)
 		}
-		return box.readUsingVisitor(existingOnly, cFn)
+		return box.readUsingVisitor(nil, existingOnly, cFn)
 	}
 }
 
@@ -539,6 +1140,10 @@ func (box *Box) GetManyExisting(ids ...uint64) (slice interface{}, err error) {
 // Returns a slice of objects that should be cast to the appropriate type.
 // The cast is done automatically when using the generated BoxFor* code.
 func (box *Box) GetAll() (slice interface{}, err error) {
+	if err := box.checkTx(false); err != nil {
+		return nil, err
+	}
+
 	const existingOnly = true
 	if supportsResultArray {
 		return box.readManyObjects(existingOnly, func() *C.OBX_bytes_array { return C.obx_box_get_all(box.cBox) })
@@ -547,7 +1152,39 @@ func (box *Box) GetAll() (slice interface{}, err error) {
 	var cFn = func(visitorArg unsafe.Pointer) C.obx_err {
 		return C.obx_box_visit_all(box.cBox, dataVisitor, visitorArg)
 	}
-	return box.readUsingVisitor(existingOnly, cFn)
+	return box.readUsingVisitor(nil, existingOnly, cFn)
+}
+
+// GetAllContext is like GetAll() but stops reading as soon as the context is done, returning the context's error.
+func (box *Box) GetAllContext(ctx context.Context) (slice interface{}, err error) {
+	if err := box.checkTx(false); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	// always use the visitor so that the context can be checked after each object
+	const existingOnly = true
+	var cFn = func(visitorArg unsafe.Pointer) C.obx_err {
+		return C.obx_box_visit_all(box.cBox, dataVisitor, visitorArg)
+	}
+	return box.readUsingVisitor(ctx, existingOnly, cFn)
+}
+
+// ForEach reads all stored objects one by one, passing each to the given function, as opposed to GetAll() which
+// collects all objects in a slice first. Return false from fn to stop the iteration early; returning an error stops
+// the iteration as well and the error is returned by ForEach.
+// fn is called inside a read transaction so it must not write to the database.
+func (box *Box) ForEach(fn func(object interface{}) (bool, error)) error {
+	if err := box.checkTx(false); err != nil {
+		return err
+	}
+
+	return box.visitObjects(fn, func(visitorArg unsafe.Pointer) C.obx_err {
+		return C.obx_box_visit_all(box.cBox, dataVisitor, visitorArg)
+	})
 }
 
 func (box *Box) readManyObjects(existingOnly bool, cFn func() *C.OBX_bytes_array) (slice interface{}, err error) {
@@ -570,7 +1207,7 @@ func (box *Box) readManyObjects(existingOnly bool, cFn func() *C.OBX_bytes_array
 				continue
 			}
 
-			object, err := binding.Load(box.ObjectBox, bytesData)
+			object, err := box.load(bytesData)
 			if err != nil {
 				return err
 			}
@@ -586,11 +1223,19 @@ func (box *Box) readManyObjects(existingOnly bool, cFn func() *C.OBX_bytes_array
 	return slice, err
 }
 
-// this is a utility function to fetch objects using an obx_data_visitor
-func (box *Box) readUsingVisitor(existingOnly bool, cFn func(visitorArg unsafe.Pointer) C.obx_err) (slice interface{}, err error) {
+// this is a utility function to fetch objects using an obx_data_visitor.
+// If ctx is given (not nil), visiting stops as soon as the context is done and its error is returned.
+func (box *Box) readUsingVisitor(ctx context.Context, existingOnly bool, cFn func(visitorArg unsafe.Pointer) C.obx_err) (slice interface{}, err error) {
 	var binding = box.entity.binding
 	var visitor uint32
 	visitor, err = dataVisitorRegister(func(bytes []byte) bool {
+		if ctx != nil {
+			if err2 := ctx.Err(); err2 != nil {
+				err = err2
+				return false
+			}
+		}
+
 		// may be nil if an object on this index was not found (can happen with GetMany)
 		if bytes == nil {
 			if !existingOnly {
@@ -599,7 +1244,7 @@ func (box *Box) readUsingVisitor(existingOnly bool, cFn func(visitorArg unsafe.P
 			return true
 		}
 
-		object, err2 := binding.Load(box.ObjectBox, bytes)
+		object, err2 := box.load(bytes)
 		if err2 != nil {
 			err = err2
 			return false
@@ -630,8 +1275,45 @@ func (box *Box) readUsingVisitor(existingOnly bool, cFn func(visitorArg unsafe.P
 	}
 }
 
+// visitObjects loads objects one by one using an obx_data_visitor, passing them to fn; see ForEach()
+func (box *Box) visitObjects(fn func(object interface{}) (bool, error), cFn func(visitorArg unsafe.Pointer) C.obx_err) error {
+	var err error
+	visitor, err := dataVisitorRegister(func(bytes []byte) bool {
+		object, err2 := box.load(bytes)
+		if err2 != nil {
+			err = err2
+			return false
+		}
+
+		next, err2 := fn(object)
+		if err2 != nil {
+			err = err2
+			return false
+		}
+		return next
+	})
+	if err != nil {
+		return err
+	}
+	defer dataVisitorUnregister(visitor)
+
+	// use another `error` variable as `err` may be set by the visitor callback above
+	var err2 = box.ObjectBox.RunInReadTx(func() error {
+		return cCall(func() C.obx_err { return cFn(unsafe.Pointer(&visitor)) })
+	})
+
+	if err2 != nil {
+		return err2
+	}
+	return err
+}
+
 // Contains checks whether an object with the given ID is stored.
 func (box *Box) Contains(id uint64) (bool, error) {
+	if err := box.checkTx(false); err != nil {
+		return false, err
+	}
+
 	var cResult C.bool
 	if err := cCall(func() C.obx_err { return C.obx_box_contains(box.cBox, C.obx_id(id), &cResult) }); err != nil {
 		return false, err
@@ -641,6 +1323,10 @@ func (box *Box) Contains(id uint64) (bool, error) {
 
 // ContainsIds checks whether all of the given objects are stored in DB.
 func (box *Box) ContainsIds(ids ...uint64) (bool, error) {
+	if err := box.checkTx(false); err != nil {
+		return false, err
+	}
+
 	cIds, err := goIdsArrayToC(ids)
 	if err != nil {
 		return false, err
@@ -656,6 +1342,10 @@ func (box *Box) ContainsIds(ids ...uint64) (bool, error) {
 
 // RelationIds returns IDs of all target objects related to the given source object ID
 func (box *Box) RelationIds(relation *RelationToMany, sourceId uint64) ([]uint64, error) {
+	if err := box.checkTx(false); err != nil {
+		return nil, err
+	}
+
 	targetBox, err := box.ObjectBox.box(relation.Target.Id)
 	if err != nil {
 		return nil, err
@@ -665,10 +1355,45 @@ func (box *Box) RelationIds(relation *RelationToMany, sourceId uint64) ([]uint64
 	})
 }
 
+// BacklinkIds returns IDs of all source objects pointing to the given target object ID using the to-one relation,
+// e.g. all orders of a customer. Must be called on the box of the source entity, i.e. the one declaring the relation.
+func (box *Box) BacklinkIds(relation *RelationToOne, targetId uint64) ([]uint64, error) {
+	if err := box.checkTx(false); err != nil {
+		return nil, err
+	}
+
+	if relation.Property.Entity.Id != box.entity.id {
+		return nil, fmt.Errorf("relation of a different entity %d passed, expected %d", relation.Property.Entity.Id,
+			box.entity.id)
+	}
+	return cGetIds(func() *C.OBX_id_array {
+		return C.obx_box_get_backlink_ids(box.cBox, C.obx_schema_id(relation.Property.Id), C.obx_id(targetId))
+	})
+}
+
+// RelationBacklinkIds returns IDs of all source objects related to the given target object ID, i.e. the reverse
+// direction of RelationIds(). Must be called on the box of the source entity, same as RelationIds().
+func (box *Box) RelationBacklinkIds(relation *RelationToMany, targetId uint64) ([]uint64, error) {
+	if err := box.checkTx(false); err != nil {
+		return nil, err
+	}
+
+	if relation.Source.Id != box.entity.id {
+		return nil, fmt.Errorf("relation of a different entity %d passed, expected %d", relation.Source.Id,
+			box.entity.id)
+	}
+	return cGetIds(func() *C.OBX_id_array {
+		return C.obx_box_rel_get_backlink_ids(box.cBox, C.obx_schema_id(relation.Id), C.obx_id(targetId))
+	})
+}
+
 // RelationReplace replaces all targets for a given source in a standalone many-to-many relation
 // It also inserts new related objects (with a 0 ID).
 func (box *Box) RelationReplace(relation *RelationToMany, sourceId uint64, sourceObject interface{},
 	targetObjects interface{}) error {
+	if err := box.checkWritable(); err != nil {
+		return err
+	}
 
 	// get id from the object, if inserting, it would be 0 even if the argument id is already non-zero
 	// this saves us an unnecessary request to RelationIds for new objects (there can't be any relations yet)
@@ -751,6 +1476,10 @@ func (box *Box) RelationReplace(relation *RelationToMany, sourceId uint64, sourc
 
 // RelationPut creates a relation between the given source & target objects
 func (box *Box) RelationPut(relation *RelationToMany, sourceId, targetId uint64) error {
+	if err := box.checkWritable(); err != nil {
+		return err
+	}
+
 	return cCall(func() C.obx_err {
 		return C.obx_box_rel_put(box.cBox, C.obx_schema_id(relation.Id), C.obx_id(sourceId), C.obx_id(targetId))
 	})
@@ -758,6 +1487,10 @@ func (box *Box) RelationPut(relation *RelationToMany, sourceId, targetId uint64)
 
 // RelationRemove removes a relation between the given source & target objects
 func (box *Box) RelationRemove(relation *RelationToMany, sourceId, targetId uint64) error {
+	if err := box.checkWritable(); err != nil {
+		return err
+	}
+
 	return cCall(func() C.obx_err {
 		return C.obx_box_rel_remove(box.cBox, C.obx_schema_id(relation.Id), C.obx_id(sourceId), C.obx_id(targetId))
 	})
@@ -23,15 +23,56 @@ package objectbox
 import "C"
 
 import (
+	"errors"
 	"fmt"
+	"math"
+	"os"
 	"runtime"
+	"time"
 	"unsafe"
 )
 
+// WalFlags configure write-ahead logging (WAL), see Builder.Wal(); combine multiple flags using bitwise OR.
+type WalFlags uint32
+
+const (
+	// WalFlagsEnable enables write-ahead logging
+	WalFlagsEnable WalFlags = C.OBXWalFlags_EnableWal
+
+	// WalFlagsNoSyncFile does not wait for the disk to acknowledge; faster but not ACID compliant (not generally recommended)
+	WalFlagsNoSyncFile WalFlags = C.OBXWalFlags_NoSyncFile
+)
+
+// ValidateOnOpenPagesFlags influence how pages are validated when opening the store, see Builder.ValidateOnOpenPages().
+type ValidateOnOpenPagesFlags uint32
+
+const (
+	// ValidateOnOpenPagesFlagsNone - no special flags, only branch pages are validated
+	ValidateOnOpenPagesFlagsNone ValidateOnOpenPagesFlags = C.OBXValidateOnOpenPagesFlags_None
+
+	// ValidateOnOpenPagesFlagsVisitLeafPages enables validation of leaf pages as well
+	ValidateOnOpenPagesFlagsVisitLeafPages ValidateOnOpenPagesFlags = C.OBXValidateOnOpenPagesFlags_VisitLeafPages
+)
+
+// ValidateOnOpenKvFlags influence how key/value pairs are validated when opening the store, see
+// Builder.ValidateOnOpenKv(). There are no values besides "None" yet.
+type ValidateOnOpenKvFlags uint32
+
+const (
+	// ValidateOnOpenKvFlagsNone - no special flags
+	ValidateOnOpenKvFlagsNone ValidateOnOpenKvFlags = C.OBXValidateOnOpenKvFlags_None
+)
+
+// WalIsAvailable returns true if the loaded ObjectBox native library supports write-ahead logging (WAL).
+func WalIsAvailable() bool {
+	return bool(C.obx_has_feature(C.OBXFeature_Wal))
+}
+
 // Builder provides tools to fully configure and construct ObjectBox
 type Builder struct {
-	model *Model
-	Error error
+	model      *Model
+	modelBytes []byte // optional, see ModelBytes()
+	Error      error
 
 	// these options are used when creating the underlying store using the C-api calls
 	// pointers are used to distinguish whether a value is present or not
@@ -39,6 +80,32 @@ type Builder struct {
 	maxSizeInKb *uint64
 	maxReaders  *uint
 
+	walFlags                 *WalFlags
+	walMaxFileSizeInKb       *uint64
+	walMaxFileSizeOnOpenInKb *uint64
+
+	validateOnOpenPageLimit  *uint64
+	validateOnOpenPagesFlags ValidateOnOpenPagesFlags
+	validateOnOpenKvFlags    *ValidateOnOpenKvFlags
+
+	backupRestoreFile  *string
+	backupRestoreFlags BackupRestoreFlags
+
+	usePreviousCommit bool
+
+	logCallback LogCallback
+
+	// async queue tuning, see the Async* methods
+	asyncMaxQueueLength            *uint64
+	asyncThrottleAtQueueLength     *uint64
+	asyncThrottleMicros            *uint32
+	asyncMaxInTxMicros             *uint32
+	asyncMaxInTxOperations         *uint32
+	asyncPreTxnDelayMicros         *uint32
+	asyncPostTxnDelayMicros        *uint32
+	asyncObjectBytesMaxCacheSize   *uint64
+	asyncObjectBytesMaxSizeToCache *uint64
+
 	// these options are passed-through to the created ObjectBox struct
 	options
 }
@@ -77,6 +144,14 @@ func (builder *Builder) MaxSizeInKb(maxSizeInKb uint64) *Builder {
 	return builder
 }
 
+// ReadOnly opens the store in read-only mode: no schema updates and no write transactions are allowed.
+// Write operations, e.g. Box.Put(), Box.Remove() or ObjectBox.RunInWriteTx(), fail fast with ErrReadOnly.
+// The database must already exist in the configured directory.
+func (builder *Builder) ReadOnly() *Builder {
+	builder.readOnly = true
+	return builder
+}
+
 // MaxReaders defines maximum concurrent readers (default: 126).
 // Increase only if you are getting errors (highly concurrent scenarios).
 func (builder *Builder) MaxReaders(maxReaders uint) *Builder {
@@ -84,6 +159,172 @@ func (builder *Builder) MaxReaders(maxReaders uint) *Builder {
 	return builder
 }
 
+// Wal configures write-ahead logging (WAL) using the given flags; pass WalFlagsEnable to turn it on.
+// Note: WAL is currently only supported for in-memory databases and requires a library with WAL support,
+// see WalIsAvailable().
+func (builder *Builder) Wal(flags WalFlags) *Builder {
+	builder.walFlags = &flags
+	return builder
+}
+
+// WalMaxFileSizeInKb defines the size the WAL file can reach after a commit before it's consolidated (default: 16 MB).
+// Consolidation takes some time; it's a trade-off between accumulating enough data and the time the consolidation takes.
+func (builder *Builder) WalMaxFileSizeInKb(sizeInKb uint64) *Builder {
+	builder.walMaxFileSizeInKb = &sizeInKb
+	return builder
+}
+
+// WalMaxFileSizeOnOpenInKb defines the size the WAL file can reach before it's consolidated when opening the database
+// (default: 4 MB). Useful if you prefer to consolidate on startup instead of on commits while the app is running.
+func (builder *Builder) WalMaxFileSizeOnOpenInKb(sizeInKb uint64) *Builder {
+	builder.walMaxFileSizeOnOpenInKb = &sizeInKb
+	return builder
+}
+
+// ValidateOnOpenPages checks consistency of up to pageLimit database pages when the store is opened.
+// Reliable file systems already guarantee consistency, so this is primarily meant to deal with unreliable OSes, file
+// systems, or hardware. Thus, usually a low number (e.g. 1-20) is sufficient and doesn't impact startup performance
+// significantly. If the validation fails, BuildOrError() returns a *CorruptionError.
+func (builder *Builder) ValidateOnOpenPages(pageLimit uint64, flags ValidateOnOpenPagesFlags) *Builder {
+	builder.validateOnOpenPageLimit = &pageLimit
+	builder.validateOnOpenPagesFlags = flags
+	return builder
+}
+
+// ValidateOnOpenKv checks key/value pairs against the internal specification when the store is opened.
+// If the validation fails, BuildOrError() returns a *CorruptionError.
+func (builder *Builder) ValidateOnOpenKv(flags ValidateOnOpenKvFlags) *Builder {
+	builder.validateOnOpenKvFlags = &flags
+	return builder
+}
+
+// BackupRestore restores the database content from the given backup file before opening the store.
+// By default, the backup is only restored if the database doesn't contain any data yet, see BackupRestoreFlags.
+// Backup files are created using ObjectBox.BackUpToFile().
+// Note: backup is a server-only feature, see BackupIsAvailable().
+func (builder *Builder) BackupRestore(backupFile string, flags BackupRestoreFlags) *Builder {
+	builder.backupRestoreFile = &backupFile
+	builder.backupRestoreFlags = flags
+	return builder
+}
+
+// UsePreviousCommit ignores the latest data snapshot (committed transaction state) and opens the previous one instead.
+// Use it as a fallback for a damaged database: if opening the store fails with a *CorruptionError, build the store again
+// with this option (and a freshly created model, e.g. ObjectBoxModel()). ObjectBox.OpenedWithPreviousCommit() then
+// reports that the previous commit was used, e.g. to trigger an alert.
+// It's recommended to use it together with ReadOnly() and to back up the database files first, to ensure no data is lost.
+func (builder *Builder) UsePreviousCommit() *Builder {
+	builder.usePreviousCommit = true
+	return builder
+}
+
+// LogCallback forwards log messages of the ObjectBox native library to the given callback, e.g. to integrate them
+// with your application's structured logging. See also LogCallbackSlog().
+// Note: this doesn't replace the default logging (to the standard output), which is more extensive at the moment.
+func (builder *Builder) LogCallback(callback LogCallback) *Builder {
+	builder.logCallback = callback
+	return builder
+}
+
+// AsyncMaxQueueLength defines the maximum number of async operations in the queue before new ones are rejected.
+// Hitting this limit usually hints that async processing can't keep up; data is produced at a faster rate than it can
+// be persisted in the background. Increasing this value isn't the only alternative, e.g. AsyncMaxInTxDuration() may
+// help too.
+func (builder *Builder) AsyncMaxQueueLength(length uint64) *Builder {
+	if builder.Error == nil && length == 0 {
+		builder.Error = errors.New("async max queue length must be greater than zero")
+	}
+	builder.asyncMaxQueueLength = &length
+	return builder
+}
+
+// AsyncThrottleAtQueueLength defines the queue length at which async producers (e.g. AsyncBox.Put()) are throttled.
+// Must not be larger than AsyncMaxQueueLength(), if that's configured too.
+func (builder *Builder) AsyncThrottleAtQueueLength(length uint64) *Builder {
+	builder.asyncThrottleAtQueueLength = &length
+	return builder
+}
+
+// AsyncThrottle defines how long throttled producers sleep on each submission, see AsyncThrottleAtQueueLength().
+// The duration is passed to the core in microseconds.
+func (builder *Builder) AsyncThrottle(duration time.Duration) *Builder {
+	builder.asyncThrottleMicros = builder.asyncMicros("throttle", duration)
+	return builder
+}
+
+// AsyncMaxInTxDuration defines the maximum duration spent in a transaction before the async queue enforces a commit.
+// This becomes relevant if the queue is constantly populated at a high rate.
+func (builder *Builder) AsyncMaxInTxDuration(duration time.Duration) *Builder {
+	builder.asyncMaxInTxMicros = builder.asyncMicros("max in-tx duration", duration)
+	return builder
+}
+
+// AsyncMaxInTxOperations defines the maximum number of operations performed in a transaction before the async queue
+// enforces a commit. This becomes relevant if the queue is constantly populated at a high rate.
+func (builder *Builder) AsyncMaxInTxOperations(operations uint32) *Builder {
+	if builder.Error == nil && operations == 0 {
+		builder.Error = errors.New("async max in-tx operations must be greater than zero")
+	}
+	builder.asyncMaxInTxOperations = &operations
+	return builder
+}
+
+// AsyncPreTxnDelay delays starting a transaction after the async queue is triggered by a new operation.
+// This gives a producer some time to submit more than a single operation before a transaction is started.
+// Note: this value should typically be low to keep latency low and prevent accumulating too many operations.
+func (builder *Builder) AsyncPreTxnDelay(delay time.Duration) *Builder {
+	builder.asyncPreTxnDelayMicros = builder.asyncMicros("pre-txn delay", delay)
+	return builder
+}
+
+// AsyncPostTxnDelay is similar to AsyncPreTxnDelay() but applies after a transaction was committed.
+// One of the purposes is to give other transactions some time to execute.
+func (builder *Builder) AsyncPostTxnDelay(delay time.Duration) *Builder {
+	builder.asyncPostTxnDelayMicros = builder.asyncMicros("post-txn delay", delay)
+	return builder
+}
+
+// AsyncObjectBytesMaxCacheSize defines the total size of the async object bytes cache (default: ~0.5 MB).
+func (builder *Builder) AsyncObjectBytesMaxCacheSize(size uint64) *Builder {
+	builder.asyncObjectBytesMaxCacheSize = &size
+	return builder
+}
+
+// AsyncObjectBytesMaxSizeToCache defines the maximum size of an object to be cached (only smaller ones are cached).
+// Must not be larger than AsyncObjectBytesMaxCacheSize(), if that's configured too.
+func (builder *Builder) AsyncObjectBytesMaxSizeToCache(size uint64) *Builder {
+	builder.asyncObjectBytesMaxSizeToCache = &size
+	return builder
+}
+
+// asyncMicros converts the given duration to microseconds, setting builder.Error if it's out of range
+func (builder *Builder) asyncMicros(name string, duration time.Duration) *uint32 {
+	var micros = duration / time.Microsecond
+	if builder.Error == nil && (micros < 0 || micros > math.MaxUint32) {
+		builder.Error = fmt.Errorf("async %s %v is out of range, expecting 0 to %v", name, duration,
+			time.Duration(math.MaxUint32)*time.Microsecond)
+	}
+	var result = uint32(micros)
+	return &result
+}
+
+// validateAsync checks async options that depend on each other
+func (builder *Builder) validateAsync() error {
+	if builder.asyncMaxQueueLength != nil && builder.asyncThrottleAtQueueLength != nil &&
+		*builder.asyncThrottleAtQueueLength > *builder.asyncMaxQueueLength {
+		return fmt.Errorf("async throttle-at queue length %d must not be larger than the max queue length %d",
+			*builder.asyncThrottleAtQueueLength, *builder.asyncMaxQueueLength)
+	}
+
+	if builder.asyncObjectBytesMaxCacheSize != nil && builder.asyncObjectBytesMaxSizeToCache != nil &&
+		*builder.asyncObjectBytesMaxSizeToCache > *builder.asyncObjectBytesMaxCacheSize {
+		return fmt.Errorf("async object bytes max size to cache %d must not be larger than the max cache size %d",
+			*builder.asyncObjectBytesMaxSizeToCache, *builder.asyncObjectBytesMaxCacheSize)
+	}
+
+	return nil
+}
+
 // asyncTimeoutTBD configures the default enqueue timeout for async operations (default is 1 second).
 // See Box.PutAsync method doc for more information.
 // TODO: implement this option in core and use it
@@ -95,11 +336,14 @@ func (builder *Builder) asyncTimeoutTBD(milliseconds uint) *Builder {
 // Model specifies schema for the database.
 //
 // Pass the result of the generated function ObjectBoxModel as an argument: Model(ObjectBoxModel())
+// To open a database without generated code, see NewModelFromJSON() and ModelBytes().
 func (builder *Builder) Model(model *Model) *Builder {
 	if builder.Error != nil {
 		return builder
 	}
 
+	builder.modelBytes = nil
+
 	builder.Error = model.validate()
 	if builder.Error != nil {
 		builder.model = nil
@@ -130,12 +374,46 @@ func (builder *Builder) BuildOrError() (*ObjectBox, error) {
 		return nil, fmt.Errorf("model is not defined")
 	}
 
+	if err := builder.validateAsync(); err != nil {
+		return nil, err
+	}
+
+	var usesWal = builder.walFlags != nil || builder.walMaxFileSizeInKb != nil || builder.walMaxFileSizeOnOpenInKb != nil
+	if usesWal && !WalIsAvailable() {
+		return nil, errors.New("write-ahead log (WAL) is not available in the loaded ObjectBox library")
+	}
+
+	var backupRestoreFile = builder.backupRestoreFile
+	if backupRestoreFile != nil {
+		if !BackupIsAvailable() {
+			return nil, errBackupNotAvailable
+		}
+
+		if builder.backupRestoreFlags&BackupRestoreFlagsIgnoreMissingFile != 0 {
+			if _, err := os.Stat(*backupRestoreFile); os.IsNotExist(err) {
+				backupRestoreFile = nil
+			}
+		}
+	}
+
+	var logCallbackId cCallbackId
+	if builder.logCallback != nil {
+		var callback = builder.logCallback
+		var err error
+		if logCallbackId, err = cCallbackRegister(cVoidIntStringCallback(func(level int, message string) {
+			callback(LogLevel(level), message)
+		})); err != nil {
+			return nil, err
+		}
+	}
+
 	// for native calls/createError()
 	runtime.LockOSThread()
 	defer runtime.UnlockOSThread()
 
 	cOptions := C.obx_opt()
 	if cOptions == nil {
+		cCallbackUnregister(logCallbackId)
 		return nil, createError()
 	}
 
@@ -144,6 +422,7 @@ func (builder *Builder) BuildOrError() (*ObjectBox, error) {
 		defer C.free(unsafe.Pointer(cDir))
 		if 0 != C.obx_opt_directory(cOptions, cDir) {
 			C.obx_opt_free(cOptions)
+			cCallbackUnregister(logCallbackId)
 			return nil, createError()
 		}
 	}
@@ -156,12 +435,108 @@ func (builder *Builder) BuildOrError() (*ObjectBox, error) {
 		C.obx_opt_max_readers(cOptions, C.uint(*builder.maxReaders))
 	}
 
-	C.obx_opt_model(cOptions, builder.model.cModel)
+	if builder.walFlags != nil {
+		C.obx_opt_wal(cOptions, C.uint32_t(*builder.walFlags))
+	}
+
+	if builder.walMaxFileSizeInKb != nil {
+		C.obx_opt_wal_max_file_size_in_kb(cOptions, C.uint64_t(*builder.walMaxFileSizeInKb))
+	}
+
+	if builder.walMaxFileSizeOnOpenInKb != nil {
+		C.obx_opt_wal_max_file_size_on_open_in_kb(cOptions, C.uint64_t(*builder.walMaxFileSizeOnOpenInKb))
+	}
+
+	if builder.validateOnOpenPageLimit != nil {
+		C.obx_opt_validate_on_open_pages(cOptions, C.size_t(*builder.validateOnOpenPageLimit),
+			C.uint32_t(builder.validateOnOpenPagesFlags))
+	}
+
+	if builder.validateOnOpenKvFlags != nil {
+		C.obx_opt_validate_on_open_kv(cOptions, C.uint32_t(*builder.validateOnOpenKvFlags))
+	}
+
+	if backupRestoreFile != nil {
+		cBackupFile := C.CString(*backupRestoreFile)
+		defer C.free(unsafe.Pointer(cBackupFile))
+		var flags = builder.backupRestoreFlags &^ BackupRestoreFlagsIgnoreMissingFile
+		C.obx_opt_backup_restore(cOptions, cBackupFile, C.uint32_t(flags))
+	}
+
+	if builder.asyncMaxQueueLength != nil {
+		C.obx_opt_async_max_queue_length(cOptions, C.size_t(*builder.asyncMaxQueueLength))
+	}
+
+	if builder.asyncThrottleAtQueueLength != nil {
+		C.obx_opt_async_throttle_at_queue_length(cOptions, C.size_t(*builder.asyncThrottleAtQueueLength))
+	}
+
+	if builder.asyncThrottleMicros != nil {
+		C.obx_opt_async_throttle_micros(cOptions, C.uint32_t(*builder.asyncThrottleMicros))
+	}
+
+	if builder.asyncMaxInTxMicros != nil {
+		C.obx_opt_async_max_in_tx_duration(cOptions, C.uint32_t(*builder.asyncMaxInTxMicros))
+	}
+
+	if builder.asyncMaxInTxOperations != nil {
+		C.obx_opt_async_max_in_tx_operations(cOptions, C.uint32_t(*builder.asyncMaxInTxOperations))
+	}
+
+	if builder.asyncPreTxnDelayMicros != nil {
+		C.obx_opt_async_pre_txn_delay(cOptions, C.uint32_t(*builder.asyncPreTxnDelayMicros))
+	}
+
+	if builder.asyncPostTxnDelayMicros != nil {
+		C.obx_opt_async_post_txn_delay(cOptions, C.uint32_t(*builder.asyncPostTxnDelayMicros))
+	}
+
+	if builder.asyncObjectBytesMaxCacheSize != nil {
+		C.obx_opt_async_object_bytes_max_cache_size(cOptions, C.uint64_t(*builder.asyncObjectBytesMaxCacheSize))
+	}
+
+	if builder.asyncObjectBytesMaxSizeToCache != nil {
+		C.obx_opt_async_object_bytes_max_size_to_cache(cOptions, C.uint64_t(*builder.asyncObjectBytesMaxSizeToCache))
+	}
+
+	if builder.usePreviousCommit {
+		C.obx_opt_use_previous_commit(cOptions, C.bool(true))
+	}
+
+	if logCallbackId != 0 {
+		C.obx_opt_log_callback(cOptions, cVoidIntStringCallbackDispatchPtr, logCallbackId.cPtr())
+	}
+
+	if builder.readOnly {
+		C.obx_opt_read_only(cOptions, C.bool(true))
+	}
+
+	if builder.modelBytes == nil {
+		C.obx_opt_model(cOptions, builder.model.cModel)
+	} else {
+		// the C model was only used for validation, the original bytes are passed to the store instead
+		C.obx_model_free(builder.model.cModel)
+		builder.model.cModel = nil
+
+		// obx_opt_model_bytes() copies the bytes so a temporary C copy is fine
+		cBytes := C.CBytes(builder.modelBytes)
+		defer C.free(cBytes)
+		if 0 != C.obx_opt_model_bytes(cOptions, cBytes, C.size_t(len(builder.modelBytes))) {
+			C.obx_opt_free(cOptions)
+			cCallbackUnregister(logCallbackId)
+			return nil, createError()
+		}
+	}
+
+	// resolved before opening the store because cOptions isn't available afterwards
+	var directory = C.GoString(C.obx_opt_get_directory(cOptions))
 
 	// cOptions is consumed by obx_store_open() so no need to free it
 	cStore := C.obx_store_open(cOptions)
 	if cStore == nil {
-		return nil, createError()
+		err := createStoreOpenError(directory)
+		cCallbackUnregister(logCallbackId)
+		return nil, err
 	}
 
 	ob := &ObjectBox{
@@ -170,6 +545,15 @@ func (builder *Builder) BuildOrError() (*ObjectBox, error) {
 		entitiesByName: builder.model.entitiesByName,
 		boxes:          make(map[TypeId]*Box, len(builder.model.entitiesById)),
 		options:        builder.options,
+		logCallbackId:  logCallbackId,
+		schema:         builder.model.schema,
+	}
+
+	if builder.modelBytes != nil {
+		if err := verifySchema(cStore, ob.schema); err != nil {
+			ob.Close()
+			return nil, err
+		}
 	}
 
 	for _, entity := range builder.model.entitiesById {
@@ -177,3 +561,17 @@ func (builder *Builder) BuildOrError() (*ObjectBox, error) {
 	}
 	return ob, nil
 }
+
+// createStoreOpenError is like createError() but recognizes corrupt database files, returning a *CorruptionError.
+func createStoreOpenError(directory string) error {
+	var err = createError().(*Error)
+	if err.Code == C.OBX_ERROR_FILE_CORRUPT || err.Code == C.OBX_ERROR_FILE_PAGES_CORRUPT {
+		return &CorruptionError{
+			Cause:        err,
+			Directory:    directory,
+			PagesCorrupt: err.Code == C.OBX_ERROR_FILE_PAGES_CORRUPT,
+			Message:      err.Error(),
+		}
+	}
+	return err
+}
@@ -22,6 +22,7 @@ package objectbox
 
 /*
 #include <stdbool.h>
+#include <stddef.h>
 #include <stdint.h>
 */
 import "C"
@@ -62,3 +63,11 @@ func cVoidConstVoidCallbackDispatch(callbackIdPtr C.uintptr_t, arg unsafe.Pointe
 		callback.callVoidConstVoid(arg)
 	}
 }
+
+//export cVoidIntStringCallbackDispatch
+func cVoidIntStringCallbackDispatch(arg1 C.int, arg2 *C.char, arg2Size C.size_t, callbackIdPtr C.uintptr_t) {
+	var callback = cCallbackLookup(callbackIdPtr)
+	if callback != nil {
+		callback.callVoidIntString(int(arg1), C.GoStringN(arg2, C.int(arg2Size)))
+	}
+}
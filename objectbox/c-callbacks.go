@@ -59,6 +59,9 @@ typedef void cVoidInt64Callback(uintptr_t callbackId, int64_t arg);
 // void return, const uintptr_t argument
 extern void cVoidConstVoidCallbackDispatch(uintptr_t callbackId);
 typedef void cVoidConstVoidCallback(uintptr_t callbackId, const void* arg);
+
+// void return, int and string arguments; note: callbackId comes last, as required by obx_log_callback
+extern void cVoidIntStringCallbackDispatch(int arg1, const char* arg2, size_t arg2Size, uintptr_t callbackId);
 */
 import "C"
 import (
@@ -75,6 +78,7 @@ type cCallable interface {
 	callVoidUint64(uint64)
 	callVoidInt64(int64)
 	callVoidConstVoid(unsafe.Pointer)
+	callVoidIntString(int, string)
 }
 
 // programming error - using an incorrect `cCallable` (arguments and return-type combination)
@@ -86,6 +90,7 @@ func (fn cVoidCallback) callVoid()                        { fn() }
 func (fn cVoidCallback) callVoidUint64(uint64)            { panic(cCallablePanicMsg) }
 func (fn cVoidCallback) callVoidInt64(int64)              { panic(cCallablePanicMsg) }
 func (fn cVoidCallback) callVoidConstVoid(unsafe.Pointer) { panic(cCallablePanicMsg) }
+func (fn cVoidCallback) callVoidIntString(int, string)    { panic(cCallablePanicMsg) }
 
 var cVoidCallbackDispatchPtr = (*C.cVoidCallback)(unsafe.Pointer(C.cVoidCallbackDispatch))
 
@@ -95,6 +100,7 @@ func (fn cVoidUint64Callback) callVoid()                        { panic(cCallabl
 func (fn cVoidUint64Callback) callVoidUint64(arg uint64)        { fn(arg) }
 func (fn cVoidUint64Callback) callVoidInt64(int64)              { panic(cCallablePanicMsg) }
 func (fn cVoidUint64Callback) callVoidConstVoid(unsafe.Pointer) { panic(cCallablePanicMsg) }
+func (fn cVoidUint64Callback) callVoidIntString(int, string)    { panic(cCallablePanicMsg) }
 
 var cVoidUint64CallbackDispatchPtr = (*C.cVoidUint64Callback)(unsafe.Pointer(C.cVoidUint64CallbackDispatch))
 
@@ -104,6 +110,7 @@ func (fn cVoidInt64Callback) callVoid()                        { panic(cCallable
 func (fn cVoidInt64Callback) callVoidUint64(uint64)            { panic(cCallablePanicMsg) }
 func (fn cVoidInt64Callback) callVoidInt64(arg int64)          { fn(arg) }
 func (fn cVoidInt64Callback) callVoidConstVoid(unsafe.Pointer) { panic(cCallablePanicMsg) }
+func (fn cVoidInt64Callback) callVoidIntString(int, string)    { panic(cCallablePanicMsg) }
 
 var cVoidInt64CallbackDispatchPtr = (*C.cVoidInt64Callback)(unsafe.Pointer(C.cVoidInt64CallbackDispatch))
 
@@ -113,9 +120,20 @@ func (fn cVoidConstVoidCallback) callVoid()                            { panic(c
 func (fn cVoidConstVoidCallback) callVoidUint64(uint64)                { panic(cCallablePanicMsg) }
 func (fn cVoidConstVoidCallback) callVoidInt64(int64)                  { panic(cCallablePanicMsg) }
 func (fn cVoidConstVoidCallback) callVoidConstVoid(arg unsafe.Pointer) { fn(arg) }
+func (fn cVoidConstVoidCallback) callVoidIntString(int, string)        { panic(cCallablePanicMsg) }
 
 var cVoidConstVoidCallbackDispatchPtr = (*C.cVoidConstVoidCallback)(unsafe.Pointer(C.cVoidConstVoidCallbackDispatch))
 
+type cVoidIntStringCallback func(int, string)
+
+func (fn cVoidIntStringCallback) callVoid()                               { panic(cCallablePanicMsg) }
+func (fn cVoidIntStringCallback) callVoidUint64(uint64)                   { panic(cCallablePanicMsg) }
+func (fn cVoidIntStringCallback) callVoidInt64(int64)                     { panic(cCallablePanicMsg) }
+func (fn cVoidIntStringCallback) callVoidConstVoid(unsafe.Pointer)        { panic(cCallablePanicMsg) }
+func (fn cVoidIntStringCallback) callVoidIntString(arg1 int, arg2 string) { fn(arg1, arg2) }
+
+var cVoidIntStringCallbackDispatchPtr = (*C.obx_log_callback)(unsafe.Pointer(C.cVoidIntStringCallbackDispatch))
+
 type cCallbackId uint32
 
 var cCallbackLastId cCallbackId
@@ -22,8 +22,9 @@ package objectbox
 */
 import "C"
 import (
-	"errors"
+	"context"
 	"runtime"
+	"sync"
 )
 
 // provides wrappers for objectbox C-api calls, making sure the returned error belongs to this call.
@@ -39,6 +40,31 @@ func cCall(fn func() C.obx_err) (err error) {
 	return err
 }
 
+// cCallContext executes fn, usually a blocking native call which can't be interrupted, in a separate goroutine so that
+// the caller can stop waiting as soon as the context is done. In that case, the context's error is returned and fn keeps
+// running in the background, its result is discarded. If calls is given (not nil), it tracks fn until it finishes.
+// Note: fn is always started, i.e. the caller is expected to check the context upfront, before preparing the call.
+func cCallContext(ctx context.Context, calls *sync.WaitGroup, fn func() error) error {
+	if calls != nil {
+		calls.Add(1)
+	}
+
+	var result = make(chan error, 1) // buffered so that an abandoned call doesn't block forever
+	go func() {
+		if calls != nil {
+			defer calls.Done()
+		}
+		result <- fn()
+	}()
+
+	select {
+	case err := <-result:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
 func cCallBool(fn func() bool) (err error) {
 	runtime.LockOSThread()
 
@@ -309,10 +335,15 @@ func cGetFloat32s(fn func() *C.OBX_float_array) (items []float32, err error) {
 // createError fetches the latest error that happened in the c-api on a current-thread.
 // The c-api uses thread-local storage for the latest error so we need to lock the current goroutine to a thread.
 // Must only be called when runtime.LockOSThread() is active. Either use one of the above cCall-style functions or a TX.
+// The result is an *Error carrying the error code, see Error.Is() for how to check for specific errors.
 func createError() error {
 	msg := C.obx_last_error_message()
 	if msg == nil {
-		return errors.New("no error info available; please report")
+		return &Error{Code: C.OBX_ERROR_NO_ERROR_INFO, Message: "no error info available; please report"}
+	}
+	return &Error{
+		Code:          int(C.obx_last_error_code()),
+		SecondaryCode: int(C.obx_last_error_secondary()),
+		Message:       C.GoString(msg),
 	}
-	return errors.New(C.GoString(msg))
 }
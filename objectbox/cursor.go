@@ -0,0 +1,193 @@
+/*
+ * Copyright 2018-2025 ObjectBox Ltd. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package objectbox
+
+/*
+#include "objectbox.h"
+*/
+import "C"
+
+import (
+	"fmt"
+	"unsafe"
+)
+
+// Cursor provides low-level access to the objects of a single entity, ordered by ID, inside a transaction.
+// As opposed to Box.GetAll(), objects are read one at a time, which allows range scans, resumable exports and keyset
+// pagination (e.g. Seek() to the last ID of the previous page and continue with Next()).
+//
+// A cursor is only valid inside the transaction it was created in and must be closed before the transaction is
+// finished. Use Box.ReadWithCursor() to have both managed automatically, or Tx.Cursor() with an explicit transaction.
+type Cursor struct {
+	box     *Box
+	tx      *Tx
+	cCursor *C.OBX_cursor
+}
+
+// ReadWithCursor runs the given function with a Cursor inside a read transaction; the cursor is closed afterwards.
+// If the box is bound to a transaction (see Tx.Box()), the cursor is created in that transaction.
+func (box *Box) ReadWithCursor(fn func(cursor *Cursor) error) error {
+	var tx = box.tx
+	if tx == nil {
+		var err error
+		if tx, err = box.ObjectBox.BeginReadTx(); err != nil {
+			return err
+		}
+		defer tx.Close()
+	}
+
+	cursor, err := tx.Cursor(box)
+	if err != nil {
+		return err
+	}
+
+	err = fn(cursor)
+	if err2 := cursor.Close(); err == nil {
+		err = err2
+	}
+	return err
+}
+
+// Cursor creates a cursor for the entity of the given box in this transaction, see Cursor for details.
+func (tx *Tx) Cursor(box *Box) (*Cursor, error) {
+	if err := tx.check(); err != nil {
+		return nil, err
+	}
+
+	if box.ObjectBox != tx.objectBox {
+		return nil, fmt.Errorf("box for entity %s belongs to a different store", box.entity.name)
+	}
+
+	var cursor = &Cursor{box: box, tx: tx}
+
+	// no need for runtime.LockOSThread() because the transaction already did that
+	if cursor.cCursor = C.obx_cursor(tx.cTxn, C.obx_schema_id(box.entity.id)); cursor.cCursor == nil {
+		return nil, createError()
+	}
+	return cursor, nil
+}
+
+// Close frees the native cursor; it's safe to call Close() multiple times.
+// Close must be called before the transaction is finished.
+func (cursor *Cursor) Close() error {
+	if cursor.cCursor == nil {
+		return nil
+	}
+
+	if err := cursor.tx.check(); err != nil {
+		return err
+	}
+
+	var rc = C.obx_cursor_close(cursor.cCursor)
+	cursor.cCursor = nil
+	if rc != 0 {
+		return createError()
+	}
+	return nil
+}
+
+func (cursor *Cursor) check() error {
+	if cursor.cCursor == nil {
+		return newIllegalStateError("cursor was closed")
+	}
+	return cursor.tx.check()
+}
+
+// First moves the cursor to the object with the lowest ID and returns it; returns nil if there are no objects.
+func (cursor *Cursor) First() (object interface{}, err error) {
+	return cursor.read(func(data *unsafe.Pointer, size *C.size_t) C.obx_err {
+		return C.obx_cursor_first(cursor.cCursor, data, size)
+	})
+}
+
+// Next moves the cursor to the following object and returns it; returns nil after the last object.
+func (cursor *Cursor) Next() (object interface{}, err error) {
+	return cursor.read(func(data *unsafe.Pointer, size *C.size_t) C.obx_err {
+		return C.obx_cursor_next(cursor.cCursor, data, size)
+	})
+}
+
+// Current returns the object at the current cursor position, e.g. after Seek(); returns nil if there's none.
+func (cursor *Cursor) Current() (object interface{}, err error) {
+	return cursor.read(func(data *unsafe.Pointer, size *C.size_t) C.obx_err {
+		return C.obx_cursor_current(cursor.cCursor, data, size)
+	})
+}
+
+// Seek moves the cursor to the object with the given ID.
+// Returns false if there's no such object, in which case the cursor position is undefined.
+func (cursor *Cursor) Seek(id uint64) (bool, error) {
+	if err := cursor.check(); err != nil {
+		return false, err
+	}
+
+	var rc = C.obx_cursor_seek(cursor.cCursor, C.obx_id(id))
+	if rc == 0 {
+		return true, nil
+	} else if rc == C.OBX_NOT_FOUND {
+		return false, nil
+	}
+	return false, createError()
+}
+
+// FirstId moves the cursor to the object with the lowest ID and returns the ID without reading the object.
+// Returns 0 if there are no objects.
+func (cursor *Cursor) FirstId() (uint64, error) {
+	return cursor.readId(func(id *C.obx_id) C.obx_err {
+		return C.obx_cursor_seek_first_id(cursor.cCursor, id)
+	})
+}
+
+// NextId moves the cursor to the following object and returns its ID without reading the object.
+// Returns 0 after the last object.
+func (cursor *Cursor) NextId() (uint64, error) {
+	return cursor.readId(func(id *C.obx_id) C.obx_err {
+		return C.obx_cursor_seek_next_id(cursor.cCursor, id)
+	})
+}
+
+func (cursor *Cursor) read(cFn func(data *unsafe.Pointer, size *C.size_t) C.obx_err) (object interface{}, err error) {
+	if err := cursor.check(); err != nil {
+		return nil, err
+	}
+
+	var dataPtr unsafe.Pointer
+	var dataSize C.size_t
+
+	var rc = cFn(&dataPtr, &dataSize)
+	if rc == 0 {
+		// the data is only valid until the cursor moves; the binding copies all values while loading
+		var bytes []byte
+		cVoidPtrToByteSlice(dataPtr, int(dataSize), &bytes)
+		return cursor.box.load(bytes)
+	} else if rc == C.OBX_NOT_FOUND {
+		return nil, nil
+	}
+	return nil, createError()
+}
+
+func (cursor *Cursor) readId(cFn func(id *C.obx_id) C.obx_err) (uint64, error) {
+	if err := cursor.check(); err != nil {
+		return 0, err
+	}
+
+	var cId C.obx_id
+	if rc := cFn(&cId); rc != 0 {
+		return 0, createError()
+	}
+	return uint64(cId), nil
+}
@@ -0,0 +1,292 @@
+/*
+ * Copyright 2018-2025 ObjectBox Ltd. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package objectbox
+
+/*
+#include <stdlib.h>
+#include "objectbox.h"
+*/
+import "C"
+
+import (
+	"fmt"
+	"unsafe"
+
+	flatbuffers "github.com/google/flatbuffers/go"
+)
+
+// DeletePolicy specifies what happens to objects pointing to an object (using a relation) when it's removed.
+// See Model.ToOneDeletePolicy() and Model.ToManyDeletePolicy().
+type DeletePolicy int
+
+const (
+	// DeletePolicyNone keeps the objects pointing to the removed object unchanged; this is the default
+	DeletePolicyNone DeletePolicy = iota
+
+	// DeletePolicyNullify clears the relation: to-one relations are set to 0, standalone relation entries are removed
+	DeletePolicyNullify
+
+	// DeletePolicyCascade removes the objects pointing to the removed object as well, applying their policies in turn
+	DeletePolicyCascade
+
+	// DeletePolicyRestrict prevents removing an object while others point to it, returning *RelationRestrictError
+	DeletePolicyRestrict
+)
+
+// deletePolicy is configured on the target entity of the relation; exactly one of toOne and toMany is set
+type deletePolicy struct {
+	policy DeletePolicy
+	toOne  *RelationToOne
+	toMany *RelationToMany
+}
+
+func (dp deletePolicy) sourceEntityId() TypeId {
+	if dp.toOne != nil {
+		return dp.toOne.Property.Entity.Id
+	}
+	return dp.toMany.Source.Id
+}
+
+// ToOneDeletePolicy sets what happens to source objects of the relation (e.g. orders) when their target object
+// (e.g. a customer) is removed by Box.Remove(), RemoveId(), RemoveIds(), RemoveAll() or Query.Remove().
+// The policy is applied in the same write transaction as the removal. Call it on the model before passing it to
+// Builder.Model(), e.g.:
+//
+//	var model = ObjectBoxModel()
+//	model.ToOneDeletePolicy(Order_.Customer, objectbox.DeletePolicyCascade)
+func (model *Model) ToOneDeletePolicy(relation *RelationToOne, policy DeletePolicy) {
+	if model.Error != nil {
+		return
+	}
+
+	source, target, err := model.deletePolicyEntities(relation.Property.Entity.Id, relation.Target.Id)
+	if err != nil {
+		model.Error = err
+		return
+	}
+
+	var property = source.schema.PropertyById(relation.Property.Id)
+	if property == nil || property.Type != PropertyTypeRelation {
+		model.Error = fmt.Errorf("can't set delete policy: property %d of entity %s is not a to-one relation",
+			relation.Property.Id, source.name)
+		return
+	}
+
+	target.setDeletePolicy(deletePolicy{policy: policy, toOne: relation})
+}
+
+// ToManyDeletePolicy sets what happens to source objects of the standalone relation when one of their target objects
+// is removed, see ToOneDeletePolicy() for details. Note: DeletePolicyNone keeps the default behaviour, i.e. the
+// relation entries of removed objects are cleaned up by the database.
+func (model *Model) ToManyDeletePolicy(relation *RelationToMany, policy DeletePolicy) {
+	if model.Error != nil {
+		return
+	}
+
+	source, target, err := model.deletePolicyEntities(relation.Source.Id, relation.Target.Id)
+	if err != nil {
+		model.Error = err
+		return
+	}
+
+	var found = false
+	for _, schemaRelation := range source.schema.Relations {
+		if schemaRelation.Id == relation.Id {
+			found = true
+			break
+		}
+	}
+	if !found {
+		model.Error = fmt.Errorf("can't set delete policy: relation %d not found in entity %s", relation.Id, source.name)
+		return
+	}
+
+	target.setDeletePolicy(deletePolicy{policy: policy, toMany: relation})
+}
+
+func (model *Model) deletePolicyEntities(sourceId, targetId TypeId) (source, target *entity, err error) {
+	if source = model.entitiesById[sourceId]; source == nil {
+		return nil, nil, fmt.Errorf("can't set delete policy: source entity %d not found", sourceId)
+	}
+	if target = model.entitiesById[targetId]; target == nil {
+		return nil, nil, fmt.Errorf("can't set delete policy: target entity %d not found", targetId)
+	}
+	return source, target, nil
+}
+
+// setDeletePolicy replaces the policy previously set for the same relation, if any
+func (entity *entity) setDeletePolicy(dp deletePolicy) {
+	var policies = entity.deletePolicies[:0]
+	for _, existing := range entity.deletePolicies {
+		if (dp.toOne == nil || existing.toOne == nil || existing.toOne.Property.Id != dp.toOne.Property.Id) &&
+			(dp.toMany == nil || existing.toMany == nil || existing.toMany.Id != dp.toMany.Id) {
+			policies = append(policies, existing)
+		}
+	}
+
+	if dp.policy != DeletePolicyNone {
+		policies = append(policies, dp)
+	}
+	entity.deletePolicies = policies
+}
+
+// removal tracks the objects removed by a single operation, including cascades
+type removal map[TypeId]map[uint64]bool
+
+// add marks the given objects as removed, returning only those that weren't marked before
+func (r removal) add(entityId TypeId, ids []uint64) []uint64 {
+	if r[entityId] == nil {
+		r[entityId] = make(map[uint64]bool)
+	}
+
+	var result = make([]uint64, 0, len(ids))
+	for _, id := range ids {
+		if !r[entityId][id] {
+			r[entityId][id] = true
+			result = append(result, id)
+		}
+	}
+	return result
+}
+
+// exclude filters out objects already marked as removed
+func (r removal) exclude(entityId TypeId, ids []uint64) []uint64 {
+	var result = make([]uint64, 0, len(ids))
+	for _, id := range ids {
+		if !r[entityId][id] {
+			result = append(result, id)
+		}
+	}
+	return result
+}
+
+// applyDeletePolicies handles objects pointing to the given objects which are about to be removed.
+// Must be called inside a write transaction, before the objects are removed.
+func (box *Box) applyDeletePolicies(ids []uint64, r removal) error {
+	ids = r.add(box.entity.id, ids)
+	if len(ids) == 0 {
+		return nil
+	}
+
+	for _, dp := range box.entity.deletePolicies {
+		sourceBox, err := box.ObjectBox.box(dp.sourceEntityId())
+		if err != nil {
+			return err
+		}
+
+		for _, id := range ids {
+			sourceIds, err := dp.sourceIds(sourceBox, id)
+			if err != nil {
+				return err
+			}
+
+			sourceIds = r.exclude(sourceBox.entity.id, sourceIds)
+			if len(sourceIds) == 0 {
+				continue
+			}
+
+			switch dp.policy {
+			case DeletePolicyRestrict:
+				return &RelationRestrictError{
+					Entity:       box.entity.name,
+					Id:           id,
+					SourceEntity: sourceBox.entity.name,
+					SourceIds:    sourceIds,
+				}
+
+			case DeletePolicyCascade:
+				if err := sourceBox.applyDeletePolicies(sourceIds, r); err != nil {
+					return err
+				}
+				if err := sourceBox.removeIdsNative(sourceIds); err != nil {
+					return err
+				}
+
+			case DeletePolicyNullify:
+				for _, sourceId := range sourceIds {
+					if dp.toOne != nil {
+						err = sourceBox.clearToOneRelation(sourceId, dp.toOne)
+					} else {
+						err = cCall(func() C.obx_err {
+							return C.obx_box_rel_remove(sourceBox.cBox, C.obx_schema_id(dp.toMany.Id),
+								C.obx_id(sourceId), C.obx_id(id))
+						})
+					}
+					if err != nil {
+						return err
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// sourceIds returns IDs of the objects pointing to the given target object
+func (dp deletePolicy) sourceIds(sourceBox *Box, targetId uint64) ([]uint64, error) {
+	return cGetIds(func() *C.OBX_id_array {
+		if dp.toOne != nil {
+			return C.obx_box_get_backlink_ids(sourceBox.cBox, C.obx_schema_id(dp.toOne.Property.Id), C.obx_id(targetId))
+		}
+		return C.obx_box_rel_get_backlink_ids(sourceBox.cBox, C.obx_schema_id(dp.toMany.Id), C.obx_id(targetId))
+	})
+}
+
+// clearToOneRelation sets the relation property of the stored object to 0. Must be called inside a write transaction.
+func (box *Box) clearToOneRelation(id uint64, relation *RelationToOne) error {
+	// the change must increment the version, see Model.VersionProperty(), which the property updater takes care of
+	if box.entity.versionProperty != nil {
+		updater, err := box.newPropertyUpdater([]PropertySetter{relation.Property.SetNil()})
+		if err != nil {
+			return err
+		}
+		_, err = updater.update(id)
+		return err
+	}
+
+	data, err := box.getRaw(id)
+	if err != nil || data == nil {
+		return err
+	}
+
+	// the data returned by getRaw() must not be modified
+	var bytes = copyBytes(data)
+	var table = &flatbuffers.Table{
+		Bytes: bytes,
+		Pos:   flatbuffers.GetUOffsetT(bytes),
+	}
+	if !table.MutateUint64Slot(flatbuffers.VOffsetT(4+2*(int(relation.Property.Id)-1)), 0) {
+		return nil // the relation isn't stored in the object, i.e. there's nothing to clear
+	}
+
+	return cCall(func() C.obx_err {
+		return C.obx_box_put5(box.cBox, C.obx_id(id), unsafe.Pointer(&bytes[0]), C.size_t(len(bytes)), cPutModeUpdate)
+	})
+}
+
+// removeIdsNative removes the given objects without applying any policies or hooks
+func (box *Box) removeIdsNative(ids []uint64) error {
+	cIds, err := goIdsArrayToC(ids)
+	if err != nil {
+		return err
+	}
+	defer cIds.free()
+
+	return cCall(func() C.obx_err {
+		return C.obx_box_remove_many(box.cBox, cIds.cArray, nil)
+	})
+}
@@ -0,0 +1,640 @@
+/*
+ * Copyright 2018-2025 ObjectBox Ltd. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package objectbox
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	flatbuffers "github.com/google/flatbuffers/go"
+	gogen "github.com/objectbox/objectbox-generator/v4/cmd/objectbox-gogen"
+	"github.com/objectbox/objectbox-go/objectbox/fbutils"
+)
+
+// DynamicBox provides CRUD access to objects of an entity without generated code, based on the Schema.
+// Objects are represented as map[string]interface{} with property names as keys.
+// It works for any store, including those opened using NewModelFromJSON() or Builder.ModelBytes().
+//
+// Values read from the database have the following types, depending on the property type and flags:
+//   - Bool: bool
+//   - Byte, Short, Int, Long: int8, int16, int32, int64 (uint8, uint16, uint32, uint64 for PropertyFlagUnsigned)
+//   - Char: uint16
+//   - Float, Double: float32, float64
+//   - String: string
+//   - Date, DateNano: int64 (milliseconds or nanoseconds since the unix epoch)
+//   - Relation and the ID property: uint64
+//   - ByteVector: []byte
+//   - StringVector: []string
+//
+// Properties without a value in the database are not present in the map. Other property types (e.g. Flex or other
+// vectors) are not supported and are skipped when reading.
+//
+// When writing, any integer or floating-point value is accepted for numeric properties, as long as it fits without
+// loss, e.g. float64 numbers resulting from encoding/json. Date and DateNano also accept time.Time.
+// Nil values are not stored. Keys that don't match any property result in an error.
+type DynamicBox struct {
+	box     *Box
+	binding *dynamicBinding
+}
+
+// DynamicBox creates a box for untyped access to objects of the given entity, see DynamicBox for details
+func (ob *ObjectBox) DynamicBox(entityName string) (*DynamicBox, error) {
+	var source = ob.entitiesByName[entityName]
+	if source == nil {
+		return nil, fmt.Errorf("entity %s not found", entityName)
+	}
+
+	binding, err := newDynamicBinding(source.schema)
+	if err != nil {
+		return nil, err
+	}
+
+	// reuse the native box of the typed Box but use the dynamic binding for all object (de)serialization
+	typedBox, err := ob.box(source.id)
+	if err != nil {
+		return nil, err
+	}
+
+	// keep everything configured on the entity (relations, version property, delete policies...), only the binding differs
+	var dynamicEntity = *source
+	dynamicEntity.binding = binding
+
+	var box = &Box{
+		ObjectBox: ob,
+		entity:    &dynamicEntity,
+		cBox:      typedBox.cBox,
+		async:     typedBox.async,
+	}
+
+	return &DynamicBox{box: box, binding: binding}, nil
+}
+
+// Entity returns the schema of the entity this box works with
+func (box *DynamicBox) Entity() *SchemaEntity {
+	return box.binding.schema
+}
+
+// Property returns a property of this entity to be used in query conditions, e.g.
+//
+//	&objectbox.PropertyInt64{BaseProperty: box.Property("Year")}.GreaterThan(2000)
+//
+// See also the typed variants, e.g. PropertyString().
+// Note: this method panics if there's no such property; it's the same kind of error as using a wrong generated property.
+func (box *DynamicBox) Property(name string) *BaseProperty {
+	var property = box.binding.schema.Property(name)
+	if property == nil {
+		panic("Configuration error; no property " + name + " in entity " + box.binding.schema.Name)
+	}
+	return &BaseProperty{
+		Id:     property.Id,
+		Entity: &Entity{Id: box.binding.schema.Id},
+	}
+}
+
+// PropertyString returns a string property to be used in query conditions, see Property()
+func (box *DynamicBox) PropertyString(name string) *PropertyString {
+	return &PropertyString{BaseProperty: box.Property(name)}
+}
+
+// PropertyStringVector returns a string vector property to be used in query conditions, see Property()
+func (box *DynamicBox) PropertyStringVector(name string) *PropertyStringVector {
+	return &PropertyStringVector{BaseProperty: box.Property(name)}
+}
+
+// PropertyInt64 returns an integer property to be used in query conditions, see Property().
+// It can be used for all integer types, including dates and relations.
+func (box *DynamicBox) PropertyInt64(name string) *PropertyInt64 {
+	return &PropertyInt64{BaseProperty: box.Property(name)}
+}
+
+// PropertyFloat64 returns a floating-point property to be used in query conditions, see Property()
+func (box *DynamicBox) PropertyFloat64(name string) *PropertyFloat64 {
+	return &PropertyFloat64{BaseProperty: box.Property(name)}
+}
+
+// PropertyBool returns a bool property to be used in query conditions, see Property()
+func (box *DynamicBox) PropertyBool(name string) *PropertyBool {
+	return &PropertyBool{BaseProperty: box.Property(name)}
+}
+
+// PropertyByteVector returns a byte vector property to be used in query conditions, see Property()
+func (box *DynamicBox) PropertyByteVector(name string) *PropertyByteVector {
+	return &PropertyByteVector{BaseProperty: box.Property(name)}
+}
+
+// Put synchronously inserts/updates a single object.
+// In case the ID is not specified, it would be assigned automatically (auto-increment) and set in the object map.
+func (box *DynamicBox) Put(object map[string]interface{}) (id uint64, err error) {
+	return box.box.put(object, false, cPutModePut)
+}
+
+// Insert synchronously inserts a single object, failing if an object with the same ID already exists.
+func (box *DynamicBox) Insert(object map[string]interface{}) (id uint64, err error) {
+	return box.box.put(object, false, cPutModeInsert)
+}
+
+// Update synchronously updates a single object, failing if an object with the same ID is not found in the database.
+func (box *DynamicBox) Update(object map[string]interface{}) error {
+	_, err := box.box.put(object, false, cPutModeUpdate)
+	return err
+}
+
+// PutMany inserts multiple objects in a single transaction, see Box.PutMany()
+func (box *DynamicBox) PutMany(objects []map[string]interface{}) (ids []uint64, err error) {
+	return box.box.PutMany(objects)
+}
+
+// Get reads a single object; returns nil in case the object with the given ID doesn't exist.
+func (box *DynamicBox) Get(id uint64) (map[string]interface{}, error) {
+	object, err := box.box.Get(id)
+	if object == nil || err != nil {
+		return nil, err
+	}
+	return object.(map[string]interface{}), nil
+}
+
+// GetMany reads multiple objects at once; missing objects are represented by nil
+func (box *DynamicBox) GetMany(ids ...uint64) ([]map[string]interface{}, error) {
+	objects, err := box.box.GetMany(ids...)
+	if objects == nil || err != nil {
+		return nil, err
+	}
+	return objects.([]map[string]interface{}), nil
+}
+
+// GetAll reads all stored objects
+func (box *DynamicBox) GetAll() ([]map[string]interface{}, error) {
+	objects, err := box.box.GetAll()
+	if objects == nil || err != nil {
+		return nil, err
+	}
+	return objects.([]map[string]interface{}), nil
+}
+
+// ForEach reads all stored objects one by one, see Box.ForEach()
+func (box *DynamicBox) ForEach(fn func(object map[string]interface{}) (bool, error)) error {
+	return box.box.ForEach(func(object interface{}) (bool, error) {
+		return fn(object.(map[string]interface{}))
+	})
+}
+
+// Remove deletes a single object
+func (box *DynamicBox) Remove(object map[string]interface{}) error {
+	return box.box.Remove(object)
+}
+
+// RemoveId deletes a single object
+func (box *DynamicBox) RemoveId(id uint64) error {
+	return box.box.RemoveId(id)
+}
+
+// RemoveIds deletes multiple objects at once, returning the number of deleted objects
+func (box *DynamicBox) RemoveIds(ids ...uint64) (uint64, error) {
+	return box.box.RemoveIds(ids...)
+}
+
+// RemoveAll removes all stored objects
+func (box *DynamicBox) RemoveAll() error {
+	return box.box.RemoveAll()
+}
+
+// Count returns a number of objects stored
+func (box *DynamicBox) Count() (uint64, error) {
+	return box.box.Count()
+}
+
+// Contains checks whether an object with the given ID is stored
+func (box *DynamicBox) Contains(id uint64) (bool, error) {
+	return box.box.Contains(id)
+}
+
+// Query creates a query with the given conditions, see Property() on how to create conditions.
+// Query.Find() returns []map[string]interface{}.
+// Note: this function panics if you try to create illegal queries, use QueryOrError for an explicit error check.
+func (box *DynamicBox) Query(conditions ...Condition) *Query {
+	return box.box.Query(conditions...)
+}
+
+// QueryOrError is like Query() but with error handling; e.g. when you build conditions dynamically that may fail.
+func (box *DynamicBox) QueryOrError(conditions ...Condition) (*Query, error) {
+	return box.box.QueryOrError(conditions...)
+}
+
+// dynamicBinding implements ObjectBinding based on the Schema, for objects of type map[string]interface{}
+type dynamicBinding struct {
+	schema     *SchemaEntity
+	idProperty *SchemaProperty
+	fieldCount int // number of FlatBuffers fields, i.e. the highest property ID
+}
+
+func newDynamicBinding(schema *SchemaEntity) (*dynamicBinding, error) {
+	var binding = &dynamicBinding{schema: schema}
+	if binding.idProperty = schema.IdProperty(); binding.idProperty == nil {
+		return nil, fmt.Errorf("entity %s doesn't have an ID property", schema.Name)
+	}
+	for _, property := range schema.Properties {
+		if int(property.Id) > binding.fieldCount {
+			binding.fieldCount = int(property.Id)
+		}
+	}
+	return binding, nil
+}
+
+// AddToModel is not used; the entity is already part of the model
+func (binding *dynamicBinding) AddToModel(model *Model) {}
+
+func (binding *dynamicBinding) GetId(object interface{}) (uint64, error) {
+	var value = object.(map[string]interface{})[binding.idProperty.Name]
+	if value == nil {
+		return 0, nil
+	}
+	id, err := dynamicUint64(value, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid ID %s: %v", binding.idProperty.Name, err)
+	}
+	return id, nil
+}
+
+func (binding *dynamicBinding) SetId(object interface{}, id uint64) error {
+	object.(map[string]interface{})[binding.idProperty.Name] = id
+	return nil
+}
+
+// PutRelated does nothing; relations are represented only by IDs of the related objects
+func (binding *dynamicBinding) PutRelated(ob *ObjectBox, object interface{}, id uint64) error {
+	return nil
+}
+
+func (binding *dynamicBinding) Flatten(object interface{}, fbb *flatbuffers.Builder, id uint64) error {
+	var values = object.(map[string]interface{})
+
+	for name := range values {
+		if binding.schema.Property(name) == nil {
+			return fmt.Errorf("unknown property %s in entity %s", name, binding.schema.Name)
+		}
+	}
+
+	// offsets (strings & vectors) must be created before the object is started
+	var offsets = make(map[TypeId]flatbuffers.UOffsetT)
+	for _, property := range binding.schema.Properties {
+		var value = values[property.Name]
+		if value == nil || property == binding.idProperty {
+			continue
+		}
+
+		switch property.Type {
+		case PropertyTypeString:
+			if str, ok := value.(string); ok {
+				offsets[property.Id] = fbutils.CreateStringOffset(fbb, str)
+			} else {
+				return dynamicTypeError(binding.schema, property, value)
+			}
+		case PropertyTypeByteVector:
+			if bytes, ok := value.([]byte); ok {
+				offsets[property.Id] = fbutils.CreateByteVectorOffset(fbb, bytes)
+			} else {
+				return dynamicTypeError(binding.schema, property, value)
+			}
+		case PropertyTypeStringVector:
+			if strings, ok := value.([]string); ok {
+				offsets[property.Id] = fbutils.CreateStringVectorOffset(fbb, strings)
+			} else {
+				return dynamicTypeError(binding.schema, property, value)
+			}
+		}
+	}
+
+	fbb.StartObject(binding.fieldCount)
+	for _, property := range binding.schema.Properties {
+		var slot = int(property.Id) - 1
+
+		if property == binding.idProperty {
+			fbutils.SetUint64Slot(fbb, slot, id)
+			continue
+		}
+
+		var value = values[property.Name]
+		if value == nil {
+			continue
+		}
+
+		if err := binding.setSlot(fbb, slot, property, value, offsets); err != nil {
+			return fmt.Errorf("property %s.%s: %v", binding.schema.Name, property.Name, err)
+		}
+	}
+	return nil
+}
+
+func (binding *dynamicBinding) setSlot(fbb *flatbuffers.Builder, slot int, property *SchemaProperty, value interface{},
+	offsets map[TypeId]flatbuffers.UOffsetT) error {
+	var unsigned = property.HasFlag(PropertyFlagUnsigned)
+
+	switch property.Type {
+	case PropertyTypeBool:
+		if v, ok := value.(bool); ok {
+			fbutils.SetBoolSlot(fbb, slot, v)
+		} else {
+			return fmt.Errorf("expected a bool value, got %T", value)
+		}
+
+	case PropertyTypeByte:
+		if unsigned {
+			v, err := dynamicUint64(value, 8)
+			fbutils.SetUint8Slot(fbb, slot, uint8(v))
+			return err
+		}
+		v, err := dynamicInt64(value, 8)
+		fbutils.SetInt8Slot(fbb, slot, int8(v))
+		return err
+
+	case PropertyTypeShort:
+		if unsigned {
+			v, err := dynamicUint64(value, 16)
+			fbutils.SetUint16Slot(fbb, slot, uint16(v))
+			return err
+		}
+		v, err := dynamicInt64(value, 16)
+		fbutils.SetInt16Slot(fbb, slot, int16(v))
+		return err
+
+	case PropertyTypeChar:
+		v, err := dynamicUint64(value, 16)
+		fbutils.SetUint16Slot(fbb, slot, uint16(v))
+		return err
+
+	case PropertyTypeInt:
+		if unsigned {
+			v, err := dynamicUint64(value, 32)
+			fbutils.SetUint32Slot(fbb, slot, uint32(v))
+			return err
+		}
+		v, err := dynamicInt64(value, 32)
+		fbutils.SetInt32Slot(fbb, slot, int32(v))
+		return err
+
+	case PropertyTypeLong:
+		if unsigned {
+			v, err := dynamicUint64(value, 64)
+			fbutils.SetUint64Slot(fbb, slot, v)
+			return err
+		}
+		v, err := dynamicInt64(value, 64)
+		fbutils.SetInt64Slot(fbb, slot, v)
+		return err
+
+	case PropertyTypeRelation:
+		v, err := dynamicUint64(value, 64)
+		fbutils.SetUint64Slot(fbb, slot, v)
+		return err
+
+	case PropertyTypeDate, PropertyTypeDateNano:
+		if t, ok := value.(time.Time); ok {
+			if property.Type == PropertyTypeDate {
+				value = t.UnixNano() / int64(time.Millisecond)
+			} else {
+				value = t.UnixNano()
+			}
+		}
+		v, err := dynamicInt64(value, 64)
+		fbutils.SetInt64Slot(fbb, slot, v)
+		return err
+
+	case PropertyTypeFloat:
+		v, err := dynamicFloat64(value)
+		fbutils.SetFloat32Slot(fbb, slot, float32(v))
+		return err
+
+	case PropertyTypeDouble:
+		v, err := dynamicFloat64(value)
+		fbutils.SetFloat64Slot(fbb, slot, v)
+		return err
+
+	case PropertyTypeString, PropertyTypeByteVector, PropertyTypeStringVector:
+		fbutils.SetUOffsetTSlot(fbb, slot, offsets[property.Id])
+
+	default:
+		return fmt.Errorf("property type %d is not supported", property.Type)
+	}
+	return nil
+}
+
+func (binding *dynamicBinding) Load(ob *ObjectBox, bytes []byte) (interface{}, error) {
+	if len(bytes) == 0 { // sanity check, should "never" happen
+		return nil, errors.New("can't deserialize an object from an empty byte vector")
+	}
+
+	var table = &flatbuffers.Table{
+		Bytes: bytes,
+		Pos:   flatbuffers.GetUOffsetT(bytes),
+	}
+
+	var object = make(map[string]interface{}, len(binding.schema.Properties))
+	for _, property := range binding.schema.Properties {
+		if value := binding.getSlot(table, property); value != nil {
+			object[property.Name] = value
+		}
+	}
+	return object, nil
+}
+
+// getSlot returns nil if the value is not present or the property type is not supported
+func (binding *dynamicBinding) getSlot(table *flatbuffers.Table, property *SchemaProperty) interface{} {
+	var slot = flatbuffers.VOffsetT(4 + 2*(int(property.Id)-1))
+	if table.Offset(slot) == 0 {
+		return nil
+	}
+
+	if property == binding.idProperty {
+		return fbutils.GetUint64Slot(table, slot)
+	}
+
+	var unsigned = property.HasFlag(PropertyFlagUnsigned)
+
+	switch property.Type {
+	case PropertyTypeBool:
+		return fbutils.GetBoolSlot(table, slot)
+	case PropertyTypeByte:
+		if unsigned {
+			return fbutils.GetUint8Slot(table, slot)
+		}
+		return fbutils.GetInt8Slot(table, slot)
+	case PropertyTypeShort:
+		if unsigned {
+			return fbutils.GetUint16Slot(table, slot)
+		}
+		return fbutils.GetInt16Slot(table, slot)
+	case PropertyTypeChar:
+		return fbutils.GetUint16Slot(table, slot)
+	case PropertyTypeInt:
+		if unsigned {
+			return fbutils.GetUint32Slot(table, slot)
+		}
+		return fbutils.GetInt32Slot(table, slot)
+	case PropertyTypeLong:
+		if unsigned {
+			return fbutils.GetUint64Slot(table, slot)
+		}
+		return fbutils.GetInt64Slot(table, slot)
+	case PropertyTypeRelation:
+		return fbutils.GetUint64Slot(table, slot)
+	case PropertyTypeDate, PropertyTypeDateNano:
+		return fbutils.GetInt64Slot(table, slot)
+	case PropertyTypeFloat:
+		return fbutils.GetFloat32Slot(table, slot)
+	case PropertyTypeDouble:
+		return fbutils.GetFloat64Slot(table, slot)
+	case PropertyTypeString:
+		return fbutils.GetStringSlot(table, slot)
+	case PropertyTypeByteVector:
+		return fbutils.GetByteVectorSlot(table, slot)
+	case PropertyTypeStringVector:
+		return fbutils.GetStringVectorSlot(table, slot)
+	}
+	return nil
+}
+
+// dynamicSupportsType checks whether values of the given type can be read (getSlot) and written (setSlot)
+func dynamicSupportsType(propertyType PropertyType) bool {
+	switch propertyType {
+	case PropertyTypeBool, PropertyTypeByte, PropertyTypeShort, PropertyTypeChar, PropertyTypeInt, PropertyTypeLong,
+		PropertyTypeRelation, PropertyTypeDate, PropertyTypeDateNano, PropertyTypeFloat, PropertyTypeDouble,
+		PropertyTypeString, PropertyTypeByteVector, PropertyTypeStringVector:
+		return true
+	}
+	return false
+}
+
+func (binding *dynamicBinding) MakeSlice(capacity int) interface{} {
+	return make([]map[string]interface{}, 0, capacity)
+}
+
+func (binding *dynamicBinding) AppendToSlice(slice interface{}, object interface{}) interface{} {
+	if object == nil {
+		return append(slice.([]map[string]interface{}), nil)
+	}
+	return append(slice.([]map[string]interface{}), object.(map[string]interface{}))
+}
+
+func (binding *dynamicBinding) GeneratorVersion() int {
+	return gogen.VersionId
+}
+
+func dynamicTypeError(entity *SchemaEntity, property *SchemaProperty, value interface{}) error {
+	return fmt.Errorf("property %s.%s: unexpected value type %T", entity.Name, property.Name, value)
+}
+
+// dynamicInt64 converts any numeric value to an int64, checking it fits into the given number of bits
+func dynamicInt64(value interface{}, bits uint) (int64, error) {
+	var result int64
+	switch v := value.(type) {
+	case int:
+		result = int64(v)
+	case int8:
+		result = int64(v)
+	case int16:
+		result = int64(v)
+	case int32:
+		result = int64(v)
+	case int64:
+		result = v
+	case uint:
+		if uint64(v) > math.MaxInt64 {
+			return 0, fmt.Errorf("value %v out of range", v)
+		}
+		result = int64(v)
+	case uint8:
+		result = int64(v)
+	case uint16:
+		result = int64(v)
+	case uint32:
+		result = int64(v)
+	case uint64:
+		if v > math.MaxInt64 {
+			return 0, fmt.Errorf("value %v out of range", v)
+		}
+		result = int64(v)
+	case float32:
+		return dynamicInt64(float64(v), bits)
+	case float64:
+		if v != math.Trunc(v) || v < math.MinInt64 || v >= math.MaxInt64 {
+			return 0, fmt.Errorf("value %v is not an integer", v)
+		}
+		result = int64(v)
+	default:
+		return 0, fmt.Errorf("expected a numeric value, got %T", value)
+	}
+
+	if bits < 64 && (result < -(1<<(bits-1)) || result >= 1<<(bits-1)) {
+		return 0, fmt.Errorf("value %v out of range", value)
+	}
+	return result, nil
+}
+
+// dynamicUint64 converts any numeric value to an uint64, checking it fits into the given number of bits
+func dynamicUint64(value interface{}, bits uint) (uint64, error) {
+	var result uint64
+	switch v := value.(type) {
+	case uint:
+		result = uint64(v)
+	case uint8:
+		result = uint64(v)
+	case uint16:
+		result = uint64(v)
+	case uint32:
+		result = uint64(v)
+	case uint64:
+		result = v
+	case float32:
+		return dynamicUint64(float64(v), bits)
+	case float64:
+		if v != math.Trunc(v) || v < 0 || v >= math.MaxUint64 {
+			return 0, fmt.Errorf("value %v is not an unsigned integer", v)
+		}
+		result = uint64(v)
+	default:
+		signed, err := dynamicInt64(value, 64)
+		if err != nil {
+			return 0, err
+		} else if signed < 0 {
+			return 0, fmt.Errorf("value %v out of range", value)
+		}
+		result = uint64(signed)
+	}
+
+	if bits < 64 && result >= 1<<bits {
+		return 0, fmt.Errorf("value %v out of range", value)
+	}
+	return result, nil
+}
+
+// dynamicFloat64 converts any numeric value to a float64
+func dynamicFloat64(value interface{}) (float64, error) {
+	switch v := value.(type) {
+	case float32:
+		return float64(v), nil
+	case float64:
+		return v, nil
+	}
+
+	signed, err := dynamicInt64(value, 64)
+	if err != nil {
+		return 0, fmt.Errorf("expected a numeric value, got %T", value)
+	}
+	return float64(signed), nil
+}
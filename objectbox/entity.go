@@ -31,4 +31,13 @@ type entity struct {
 
 	// whether this entity has any relations (standalone or property-rels) - configured during model creation
 	hasRelations bool
+
+	// schema as declared during model creation
+	schema *SchemaEntity
+
+	// optimistic concurrency control property, see Model.VersionProperty(); nil if not configured
+	versionProperty *SchemaProperty
+
+	// relations pointing to this entity that need handling when an object is removed, see Model.ToOneDeletePolicy()
+	deletePolicies []deletePolicy
 }
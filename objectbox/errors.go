@@ -0,0 +1,247 @@
+/*
+ * Copyright 2018-2025 ObjectBox Ltd. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package objectbox
+
+/*
+#include "objectbox.h"
+*/
+import "C"
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Error is returned by operations failing in the ObjectBox core, as well as for some equivalent checks done in Go.
+// Use errors.Is() with the sentinel values below to check for a specific kind of error, e.g.
+//
+//	if errors.Is(err, objectbox.ErrUniqueViolation) { ... }
+type Error struct {
+	// Code is the error code of the ObjectBox core (OBX_ERROR_* in objectbox.h), e.g. C.OBX_ERROR_UNIQUE_VIOLATED
+	Code int
+
+	// SecondaryCode is the underlying error code, e.g. a platform-specific one for a generic storage error; may be 0
+	SecondaryCode int
+
+	// Message is the error description
+	Message string
+}
+
+// Sentinel errors to be used with errors.Is(); errors are matched by their code, see Error.Is()
+var (
+	// ErrIllegalState is returned if an operation isn't allowed in the current state, e.g. on a closed query
+	ErrIllegalState = &Error{Code: C.OBX_ERROR_ILLEGAL_STATE, Message: "illegal state"}
+
+	// ErrIllegalArgument is returned if an argument is invalid, e.g. updating an object with ID 0
+	ErrIllegalArgument = &Error{Code: C.OBX_ERROR_ILLEGAL_ARGUMENT, Message: "illegal argument"}
+
+	// ErrNotFound is returned if the object to work with doesn't exist, e.g. by Box.Update()
+	ErrNotFound = &Error{Code: C.OBX_NOT_FOUND, Message: "not found"}
+
+	// ErrIdAlreadyExists is returned by Box.Insert() if an object with the given ID already exists
+	ErrIdAlreadyExists = &Error{Code: C.OBX_ERROR_ID_ALREADY_EXISTS, Message: "ID already exists"}
+
+	// ErrUniqueViolation is returned if a put would result in two objects with the same value of a unique property
+	ErrUniqueViolation = &Error{Code: C.OBX_ERROR_UNIQUE_VIOLATED, Message: "unique constraint violated"}
+
+	// ErrDbFull is returned if the database reached the size limit, see Builder.MaxSizeInKb()
+	ErrDbFull = &Error{Code: C.OBX_ERROR_DB_FULL, Message: "database full"}
+
+	// ErrFileCorrupt is matched by a *CorruptionError if illegal values or structural inconsistencies were detected
+	ErrFileCorrupt = &Error{Code: C.OBX_ERROR_FILE_CORRUPT, Message: "file corrupt"}
+
+	// ErrFilePagesCorrupt is matched by a *CorruptionError if database pages are corrupt
+	ErrFilePagesCorrupt = &Error{Code: C.OBX_ERROR_FILE_PAGES_CORRUPT, Message: "file pages corrupt"}
+)
+
+func (err *Error) Error() string {
+	return err.Message
+}
+
+// Is makes errors.Is() match errors with the same code; ErrNotFound additionally matches "ID not found" errors
+// of the core, e.g. an update of an object which was removed in the meantime. ErrReadOnly is an exception: it matches
+// ErrIllegalState but only ErrReadOnly itself matches it, not any other illegal-state error.
+func (err *Error) Is(target error) bool {
+	targetErr, ok := target.(*Error)
+	if !ok {
+		return false
+	}
+
+	if target == ErrReadOnly {
+		return error(err) == ErrReadOnly
+	}
+
+	if targetErr.Code == C.OBX_NOT_FOUND {
+		return err.Code == C.OBX_NOT_FOUND || err.Code == C.OBX_ERROR_ID_NOT_FOUND
+	}
+	return err.Code == targetErr.Code
+}
+
+// UniqueViolationError is returned by Box.Put(), Insert() etc. if an object couldn't be stored because a property with
+// a unique index would contain the same value as in another object. It matches ErrUniqueViolation, i.e. errors.Is()
+// can be used the same way as with other errors; use a type assertion (or errors.As()) to access the details.
+type UniqueViolationError struct {
+	// Cause is the error as reported by the ObjectBox core
+	Cause *Error
+
+	// Entity and Property are the names of the entity and its unique property the conflict was found for
+	Entity   string
+	Property string
+
+	// Value is the conflicting value of the property, e.g. a string or an int64
+	Value interface{}
+
+	// ExistingId is the ID of the object already using the value; 0 if it couldn't be determined or if the value is
+	// used by more than one of the objects being put at once
+	ExistingId uint64
+}
+
+func (err *UniqueViolationError) Error() string {
+	var msg = fmt.Sprintf("unique constraint violated: %s.%s value %v", err.Entity, err.Property, err.Value)
+	if err.ExistingId != 0 {
+		msg = msg + fmt.Sprintf(" is already used by object ID %d", err.ExistingId)
+	}
+	return msg + ": " + err.Cause.Message
+}
+
+// Is makes errors.Is() match ErrUniqueViolation, see Error.Is()
+func (err *UniqueViolationError) Is(target error) bool {
+	return err.Cause.Is(target)
+}
+
+// Unwrap returns the original *Error, see Cause
+func (err *UniqueViolationError) Unwrap() error {
+	return err.Cause
+}
+
+// PutManyError is returned by Box.InsertMany() and UpdateMany() if some of the objects were skipped because they would
+// fail the put mode (e.g. updating an object that doesn't exist). All other objects were stored nonetheless.
+type PutManyError struct {
+	// Failures maps the index of each skipped object (in the given slice) to the reason, e.g. an error matching
+	// ErrIdAlreadyExists or ErrNotFound
+	Failures map[int]error
+}
+
+func (err *PutManyError) Error() string {
+	// report the first failure so that the message is deterministic
+	var first = -1
+	for index := range err.Failures {
+		if first == -1 || index < first {
+			first = index
+		}
+	}
+	return fmt.Sprintf("%d object(s) could not be stored, e.g. objects[%d]: %v", len(err.Failures), first,
+		err.Failures[first])
+}
+
+// ErrVersionConflict is matched by *VersionConflictError, see Model.VersionProperty()
+var ErrVersionConflict = errors.New("version conflict")
+
+// VersionConflictError is returned by Box.Put(), Update() and others for entities with a version property (see
+// Model.VersionProperty()) if the version of the object being written differs from the stored one, i.e. the object
+// was changed by someone else since it was read. It matches ErrVersionConflict.
+type VersionConflictError struct {
+	// Entity is the name of the entity
+	Entity string
+
+	// Id of the conflicting object
+	Id uint64
+
+	// Version is the version of the object being written
+	Version int64
+
+	// StoredVersion is the version currently stored in the database; 0 if the object doesn't exist (anymore)
+	StoredVersion int64
+}
+
+func (err *VersionConflictError) Error() string {
+	return fmt.Sprintf("version conflict: %s object ID %d has version %d but %d is stored", err.Entity, err.Id,
+		err.Version, err.StoredVersion)
+}
+
+// Is makes errors.Is() match ErrVersionConflict
+func (err *VersionConflictError) Is(target error) bool {
+	return target == ErrVersionConflict
+}
+
+// ErrRelationRestricted is matched by *RelationRestrictError, see DeletePolicyRestrict
+var ErrRelationRestricted = errors.New("relation restricted")
+
+// RelationRestrictError is returned by Box.Remove() and others if an object can't be removed because other objects
+// still point to it using a relation with DeletePolicyRestrict. Nothing is removed in that case.
+// It matches ErrRelationRestricted.
+type RelationRestrictError struct {
+	// Entity and Id identify the object that couldn't be removed
+	Entity string
+	Id     uint64
+
+	// SourceEntity is the name of the entity pointing to the object, SourceIds are the IDs of those objects
+	SourceEntity string
+	SourceIds    []uint64
+}
+
+func (err *RelationRestrictError) Error() string {
+	return fmt.Sprintf("cannot remove %s object ID %d: %d %s object(s) point to it using a relation with a restrict "+
+		"delete policy", err.Entity, err.Id, len(err.SourceIds), err.SourceEntity)
+}
+
+// Is makes errors.Is() match ErrRelationRestricted
+func (err *RelationRestrictError) Is(target error) bool {
+	return target == ErrRelationRestricted
+}
+
+// newIllegalStateError creates an *Error matching ErrIllegalState with the given description
+func newIllegalStateError(message string) *Error {
+	return &Error{Code: C.OBX_ERROR_ILLEGAL_STATE, Message: "illegal state; " + message}
+}
+
+// CorruptionError is returned when a database could not be opened because its files are corrupt.
+// This is usually detected by the validation configured using Builder.ValidateOnOpenPages() and ValidateOnOpenKv().
+// A typical reaction is to move the directory aside (quarantine) and restore the database from a backup.
+// It matches ErrFileCorrupt or ErrFilePagesCorrupt, depending on the kind of corruption, see Cause.
+type CorruptionError struct {
+	// Cause is the error as reported by the ObjectBox core, its Code is either OBX_ERROR_FILE_CORRUPT or
+	// OBX_ERROR_FILE_PAGES_CORRUPT
+	Cause *Error
+
+	// Directory of the database that failed to open
+	Directory string
+
+	// PagesCorrupt is true if the error is related to database pages, e.g. bad page references outside of the file.
+	// Otherwise, illegal values or structural inconsistencies were detected.
+	PagesCorrupt bool
+
+	// Message is the error description provided by the ObjectBox core
+	Message string
+}
+
+func (err *CorruptionError) Error() string {
+	return fmt.Sprintf("database in %s is corrupt: %s", err.Directory, err.Message)
+}
+
+// Is makes errors.Is() match ErrFileCorrupt or ErrFilePagesCorrupt, see Error.Is()
+func (err *CorruptionError) Is(target error) bool {
+	return err.Cause != nil && err.Cause.Is(target)
+}
+
+// Unwrap returns the original *Error, see Cause
+func (err *CorruptionError) Unwrap() error {
+	if err.Cause == nil {
+		return nil
+	}
+	return err.Cause
+}
@@ -0,0 +1,177 @@
+/*
+ * Copyright 2018-2025 ObjectBox Ltd. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package objectbox
+
+import "fmt"
+
+// Lifecycle hooks are optional interfaces implemented by objects (i.e. the entity struct) or their ObjectBinding.
+// Box operations check for them and invoke the hooks of the binding first, then those of the object.
+// Put and remove hooks are executed inside the write transaction of the operation; returning an error aborts it,
+// i.e. no changes of the operation are persisted. Load hooks are executed inside the read transaction.
+//
+// Notes:
+//   - Box.RemoveAll() and Query.Remove() don't invoke BeforeRemove hooks, neither do removals by DeletePolicyCascade.
+//   - AsyncBox only invokes BeforePut hooks, before the object is queued, i.e. outside of a transaction.
+//   - Box.UpdateProperties() and Query.Update() change the stored data directly, without the typed objects,
+//     therefore they don't invoke any put hooks, neither those of the objects nor those of the binding.
+//   - Hooks must not start transactions on other goroutines - that would deadlock with the running write transaction.
+
+// BeforePutHook is implemented by objects that need to be notified before they're written by Box.Put(), Insert(),
+// Update(), PutMany() and others, e.g. to set audit timestamps, normalize fields or reject invalid objects.
+type BeforePutHook interface {
+	BeforePut() error
+}
+
+// AfterPutHook is implemented by objects that need to be notified after they have been written.
+// The ID of a new object is already set when the hook is called.
+type AfterPutHook interface {
+	AfterPut() error
+}
+
+// BeforeRemoveHook is implemented by objects that need to be notified before they're removed by Box.Remove()
+type BeforeRemoveHook interface {
+	BeforeRemove() error
+}
+
+// AfterLoadHook is implemented by objects that need to be notified after they have been read, e.g. by Box.Get(),
+// GetAll() or Query.Find()
+type AfterLoadHook interface {
+	AfterLoad() error
+}
+
+// BindingBeforePutHook is the ObjectBinding alternative to BeforePutHook, for all objects of an entity
+type BindingBeforePutHook interface {
+	BeforePut(ob *ObjectBox, object interface{}) error
+}
+
+// BindingAfterPutHook is the ObjectBinding alternative to AfterPutHook, for all objects of an entity
+type BindingAfterPutHook interface {
+	AfterPut(ob *ObjectBox, object interface{}, id uint64) error
+}
+
+// BindingBeforeRemoveHook is the ObjectBinding alternative to BeforeRemoveHook. Because the binding is notified for
+// objects removed by their ID (e.g. Box.RemoveId() and RemoveIds()) as well, it only receives the ID.
+type BindingBeforeRemoveHook interface {
+	BeforeRemove(ob *ObjectBox, id uint64) error
+}
+
+// BindingAfterLoadHook is the ObjectBinding alternative to AfterLoadHook, for all objects of an entity
+type BindingAfterLoadHook interface {
+	AfterLoad(ob *ObjectBox, object interface{}) error
+}
+
+// WrapBinding replaces the binding of a registered entity with the one returned by wrap, which receives the original.
+// This allows adding binding hooks to generated bindings without changing the generated code, e.g.
+//
+//	var m = ObjectBoxModel()
+//	m.WrapBinding(PersonBinding.Id, func(binding objectbox.ObjectBinding) objectbox.ObjectBinding {
+//		return &auditBinding{ObjectBinding: binding} // implements BindingBeforePutHook
+//	})
+func (model *Model) WrapBinding(entityId TypeId, wrap func(binding ObjectBinding) ObjectBinding) {
+	if model.Error != nil {
+		return
+	}
+
+	var entity = model.entitiesById[entityId]
+	if entity == nil || entity.binding == nil {
+		model.Error = fmt.Errorf("can't wrap binding: entity %d not found or registered without a binding", entityId)
+		return
+	}
+
+	if binding := wrap(entity.binding); binding == nil {
+		model.Error = fmt.Errorf("can't wrap binding: nil returned for entity %s", entity.name)
+	} else {
+		entity.binding = binding
+	}
+}
+
+// hasPutHooks checks whether the object (or the binding) implements any of the put hooks
+func (box *Box) hasPutHooks(object interface{}) bool {
+	switch object.(type) {
+	case BeforePutHook, AfterPutHook:
+		return true
+	}
+	switch box.entity.binding.(type) {
+	case BindingBeforePutHook, BindingAfterPutHook:
+		return true
+	}
+	return false
+}
+
+// hasRemoveHooks checks whether the binding implements BindingBeforeRemoveHook
+func (box *Box) hasRemoveHooks() bool {
+	_, ok := box.entity.binding.(BindingBeforeRemoveHook)
+	return ok
+}
+
+func (box *Box) beforePut(object interface{}) error {
+	if hook, ok := box.entity.binding.(BindingBeforePutHook); ok {
+		if err := hook.BeforePut(box.ObjectBox, object); err != nil {
+			return err
+		}
+	}
+	if hook, ok := object.(BeforePutHook); ok {
+		return hook.BeforePut()
+	}
+	return nil
+}
+
+// afterPut must be called with the ID already set on the object
+func (box *Box) afterPut(object interface{}, id uint64) error {
+	if hook, ok := box.entity.binding.(BindingAfterPutHook); ok {
+		if err := hook.AfterPut(box.ObjectBox, object, id); err != nil {
+			return err
+		}
+	}
+	if hook, ok := object.(AfterPutHook); ok {
+		return hook.AfterPut()
+	}
+	return nil
+}
+
+// beforeRemove notifies the binding and, if given (not nil), the object
+func (box *Box) beforeRemove(id uint64, object interface{}) error {
+	if hook, ok := box.entity.binding.(BindingBeforeRemoveHook); ok {
+		if err := hook.BeforeRemove(box.ObjectBox, id); err != nil {
+			return err
+		}
+	}
+	if hook, ok := object.(BeforeRemoveHook); ok {
+		return hook.BeforeRemove()
+	}
+	return nil
+}
+
+// load reads the object from FlatBuffers data using the binding and invokes the load hooks
+func (box *Box) load(bytes []byte) (interface{}, error) {
+	object, err := box.entity.binding.Load(box.ObjectBox, bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	if hook, ok := box.entity.binding.(BindingAfterLoadHook); ok {
+		if err := hook.AfterLoad(box.ObjectBox, object); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := object.(AfterLoadHook); ok {
+		if err := hook.AfterLoad(); err != nil {
+			return nil, err
+		}
+	}
+	return object, nil
+}
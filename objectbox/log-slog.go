@@ -0,0 +1,51 @@
+//go:build go1.21
+// +build go1.21
+
+/*
+ * Copyright 2018-2025 ObjectBox Ltd. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package objectbox
+
+import (
+	"context"
+	"log/slog"
+)
+
+// LogCallbackSlog creates a LogCallback forwarding native log messages to the given structured logger, e.g.
+//
+//	objectbox.NewBuilder().LogCallback(objectbox.LogCallbackSlog(slog.Default()))
+//
+// LogLevelVerbose is mapped to a level below slog.LevelDebug, other levels to their slog counterparts.
+func LogCallbackSlog(logger *slog.Logger) LogCallback {
+	return func(level LogLevel, message string) {
+		logger.Log(context.Background(), level.slogLevel(), message)
+	}
+}
+
+func (level LogLevel) slogLevel() slog.Level {
+	switch {
+	case level >= LogLevelError:
+		return slog.LevelError
+	case level >= LogLevelWarn:
+		return slog.LevelWarn
+	case level >= LogLevelInfo:
+		return slog.LevelInfo
+	case level >= LogLevelDebug:
+		return slog.LevelDebug
+	default:
+		return slog.LevelDebug - 4
+	}
+}
@@ -0,0 +1,63 @@
+/*
+ * Copyright 2018-2025 ObjectBox Ltd. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package objectbox
+
+/*
+#include "objectbox.h"
+*/
+import "C"
+import "strconv"
+
+// LogLevel is the severity of a log message issued by the ObjectBox native library
+type LogLevel int
+
+const (
+	// LogLevelVerbose is used for very detailed messages, usually only relevant when debugging
+	LogLevelVerbose LogLevel = C.OBXLogLevel_Verbose
+
+	// LogLevelDebug is used for debugging messages
+	LogLevelDebug LogLevel = C.OBXLogLevel_Debug
+
+	// LogLevelInfo is used for informational messages, e.g. about the store being opened
+	LogLevelInfo LogLevel = C.OBXLogLevel_Info
+
+	// LogLevelWarn is used for unexpected situations that ObjectBox can recover from
+	LogLevelWarn LogLevel = C.OBXLogLevel_Warn
+
+	// LogLevelError is used for errors
+	LogLevelError LogLevel = C.OBXLogLevel_Error
+)
+
+func (level LogLevel) String() string {
+	switch level {
+	case LogLevelVerbose:
+		return "VERBOSE"
+	case LogLevelDebug:
+		return "DEBUG"
+	case LogLevelInfo:
+		return "INFO"
+	case LogLevelWarn:
+		return "WARN"
+	case LogLevelError:
+		return "ERROR"
+	}
+	return "LogLevel(" + strconv.Itoa(int(level)) + ")"
+}
+
+// LogCallback receives log messages issued by the ObjectBox native library, see Builder.LogCallback().
+// It may be called concurrently from internal threads, so it must be safe for concurrent use.
+type LogCallback func(level LogLevel, message string)
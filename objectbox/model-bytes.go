@@ -0,0 +1,196 @@
+/*
+ * Copyright 2018-2025 ObjectBox Ltd. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package objectbox
+
+/*
+#include <stdlib.h>
+#include "objectbox.h"
+*/
+import "C"
+
+import (
+	"fmt"
+	"unsafe"
+
+	flatbuffers "github.com/google/flatbuffers/go"
+	"github.com/objectbox/objectbox-go/objectbox/fbutils"
+)
+
+// Field slots of the FlatBuffers model tables (objectbox-model.fbs), i.e. the format accepted by obx_opt_model_bytes()
+const (
+	fbModelEntities       flatbuffers.VOffsetT = 4 + 2*3
+	fbModelLastEntityId   flatbuffers.VOffsetT = 4 + 2*4
+	fbModelLastIndexId    flatbuffers.VOffsetT = 4 + 2*5
+	fbModelLastRelationId flatbuffers.VOffsetT = 4 + 2*7
+
+	fbEntityId             flatbuffers.VOffsetT = 4 + 2*0
+	fbEntityName           flatbuffers.VOffsetT = 4 + 2*1
+	fbEntityProperties     flatbuffers.VOffsetT = 4 + 2*2
+	fbEntityLastPropertyId flatbuffers.VOffsetT = 4 + 2*3
+	fbEntityRelations      flatbuffers.VOffsetT = 4 + 2*4
+	fbEntityFlags          flatbuffers.VOffsetT = 4 + 2*5
+
+	fbPropertyId           flatbuffers.VOffsetT = 4 + 2*0
+	fbPropertyName         flatbuffers.VOffsetT = 4 + 2*1
+	fbPropertyType         flatbuffers.VOffsetT = 4 + 2*2
+	fbPropertyFlags        flatbuffers.VOffsetT = 4 + 2*3
+	fbPropertyIndexId      flatbuffers.VOffsetT = 4 + 2*4
+	fbPropertyTargetEntity flatbuffers.VOffsetT = 4 + 2*5
+
+	fbRelationId             flatbuffers.VOffsetT = 4 + 2*0
+	fbRelationTargetEntityId flatbuffers.VOffsetT = 4 + 2*2
+)
+
+// ModelBytes specifies schema for the database as a serialized FlatBuffers model, as an alternative to Model().
+// This allows opening a database whose Go types are not available, e.g. in generic tools.
+// Because there are no bindings, objects can't be accessed using the typed (generated) boxes;
+// use ObjectBox.Schema() to inspect the model instead.
+func (builder *Builder) ModelBytes(bytes []byte) *Builder {
+	if builder.Error != nil {
+		return builder
+	}
+
+	schema, err := schemaFromModelBytes(bytes)
+	if err != nil {
+		builder.Error = err
+		return builder
+	}
+
+	// the Go-side entities are created the same way as for NewModelFromJSON(); the C model itself is only used to
+	// validate the schema, the original bytes are passed to the store so that no information is lost.
+	var model = newModelFromSchema(schema)
+	if model.Error == nil {
+		model.Error = model.validate()
+	}
+	if model.Error != nil {
+		builder.Error = fmt.Errorf("invalid model bytes: %v", model.Error)
+		return builder
+	}
+
+	builder.model = model
+	builder.modelBytes = bytes
+	return builder
+}
+
+// verifySchema checks the schema parsed on the Go side matches the store
+func verifySchema(store *C.OBX_store, schema *Schema) error {
+	for _, entity := range schema.Entities {
+		var cName = C.CString(entity.Name)
+		var entityId = TypeId(C.obx_store_entity_id(store, cName))
+		C.free(unsafe.Pointer(cName))
+		if entityId != entity.Id {
+			return fmt.Errorf("invalid model bytes: entity %s has ID %d in the store, expected %d", entity.Name, entityId, entity.Id)
+		}
+
+		for _, property := range entity.Properties {
+			var cName = C.CString(property.Name)
+			var propertyId = TypeId(C.obx_store_entity_property_id(store, C.obx_schema_id(entity.Id), cName))
+			C.free(unsafe.Pointer(cName))
+			if propertyId != property.Id {
+				return fmt.Errorf("invalid model bytes: property %s.%s has ID %d in the store, expected %d",
+					entity.Name, property.Name, propertyId, property.Id)
+			}
+		}
+	}
+	return nil
+}
+
+// schemaFromModelBytes parses the FlatBuffers model
+func schemaFromModelBytes(bytes []byte) (schema *Schema, err error) {
+	if len(bytes) < flatbuffers.SizeUOffsetT {
+		return nil, fmt.Errorf("invalid model bytes: too short")
+	}
+
+	// reading malformed FlatBuffers panics (index out of range)
+	defer func() {
+		if r := recover(); r != nil {
+			schema = nil
+			err = fmt.Errorf("invalid model bytes: %v", r)
+		}
+	}()
+
+	var table = &flatbuffers.Table{
+		Bytes: bytes,
+		Pos:   flatbuffers.GetUOffsetT(bytes),
+	}
+
+	schema = &Schema{}
+	schema.LastEntityId, schema.LastEntityUid = fbIdUidSlot(table, fbModelLastEntityId)
+	schema.LastIndexId, schema.LastIndexUid = fbIdUidSlot(table, fbModelLastIndexId)
+	schema.LastRelationId, schema.LastRelationUid = fbIdUidSlot(table, fbModelLastRelationId)
+
+	for _, entityTable := range fbTableVectorSlot(table, fbModelEntities) {
+		var entity = &SchemaEntity{
+			Name:  fbutils.GetStringSlot(entityTable, fbEntityName),
+			Flags: int(entityTable.GetUint32Slot(fbEntityFlags, 0)),
+		}
+		entity.Id, entity.Uid = fbIdUidSlot(entityTable, fbEntityId)
+		entity.LastPropertyId, entity.LastPropertyUid = fbIdUidSlot(entityTable, fbEntityLastPropertyId)
+
+		for _, propertyTable := range fbTableVectorSlot(entityTable, fbEntityProperties) {
+			var property = &SchemaProperty{
+				Name:           fbutils.GetStringSlot(propertyTable, fbPropertyName),
+				Type:           PropertyType(propertyTable.GetUint16Slot(fbPropertyType, 0)),
+				Flags:          int(propertyTable.GetUint32Slot(fbPropertyFlags, 0)),
+				RelationTarget: fbutils.GetStringSlot(propertyTable, fbPropertyTargetEntity),
+			}
+			property.Id, property.Uid = fbIdUidSlot(propertyTable, fbPropertyId)
+			property.IndexId, property.IndexUid = fbIdUidSlot(propertyTable, fbPropertyIndexId)
+			entity.Properties = append(entity.Properties, property)
+		}
+
+		for _, relationTable := range fbTableVectorSlot(entityTable, fbEntityRelations) {
+			var relation = &SchemaRelation{}
+			relation.Id, relation.Uid = fbIdUidSlot(relationTable, fbRelationId)
+			relation.TargetEntityId, relation.TargetEntityUid = fbIdUidSlot(relationTable, fbRelationTargetEntityId)
+			entity.Relations = append(entity.Relations, relation)
+		}
+
+		schema.Entities = append(schema.Entities, entity)
+	}
+
+	return schema, nil
+}
+
+// fbIdUidSlot reads the IdUid struct (uint32 id, 4 bytes padding, uint64 uid) stored inline in the table
+func fbIdUidSlot(table *flatbuffers.Table, slot flatbuffers.VOffsetT) (TypeId, uint64) {
+	if o := table.Offset(slot); o != 0 {
+		var pos = table.Pos + flatbuffers.UOffsetT(o)
+		return TypeId(table.GetUint32(pos)), table.GetUint64(pos + 8)
+	}
+	return 0, 0
+}
+
+// fbTableVectorSlot reads a vector of tables
+func fbTableVectorSlot(table *flatbuffers.Table, slot flatbuffers.VOffsetT) []*flatbuffers.Table {
+	var o = flatbuffers.UOffsetT(table.Offset(slot))
+	if o == 0 {
+		return nil
+	}
+
+	var ln = table.VectorLen(o)
+	var start = table.Vector(o)
+	var result = make([]*flatbuffers.Table, ln)
+	for i := 0; i < ln; i++ {
+		var pos = start + flatbuffers.UOffsetT(i*flatbuffers.SizeUOffsetT)
+		result[i] = &flatbuffers.Table{
+			Bytes: table.Bytes,
+			Pos:   table.Indirect(pos),
+		}
+	}
+	return result
+}
@@ -0,0 +1,211 @@
+/*
+ * Copyright 2018-2025 ObjectBox Ltd. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package objectbox
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strconv"
+	"strings"
+
+	gogen "github.com/objectbox/objectbox-generator/v4/cmd/objectbox-gogen"
+)
+
+// jsonModel mirrors the parts of objectbox-model.json relevant to the database schema
+type jsonModel struct {
+	Entities       []jsonEntity `json:"entities"`
+	LastEntityId   string       `json:"lastEntityId"`
+	LastIndexId    string       `json:"lastIndexId"`
+	LastRelationId string       `json:"lastRelationId"`
+}
+
+type jsonEntity struct {
+	Id             string         `json:"id"`
+	Name           string         `json:"name"`
+	Flags          int            `json:"flags"`
+	LastPropertyId string         `json:"lastPropertyId"`
+	Properties     []jsonProperty `json:"properties"`
+	Relations      []jsonRelation `json:"relations"`
+}
+
+type jsonProperty struct {
+	Id             string `json:"id"`
+	Name           string `json:"name"`
+	Type           int    `json:"type"`
+	Flags          int    `json:"flags"`
+	IndexId        string `json:"indexId"`
+	RelationTarget string `json:"relationTarget"`
+}
+
+type jsonRelation struct {
+	Id       string `json:"id"`
+	Name     string `json:"name"`
+	TargetId string `json:"targetId"`
+}
+
+// NewModelFromJSONFile reads the model from an objectbox-model.json file, as maintained by the ObjectBox generator.
+// See NewModelFromJSON() for details.
+func NewModelFromJSONFile(path string) (*Model, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return NewModelFromJSON(data)
+}
+
+// NewModelFromJSON creates a model from the contents of an objectbox-model.json file, without any generated code.
+// This allows opening a database whose Go types are not available, e.g. in generic tools.
+// Because there are no bindings, objects can't be accessed using the typed (generated) boxes;
+// use ObjectBox.Schema() to inspect the model and ObjectBox.DynamicBox() to access the objects instead.
+// Untyped boxes, i.e. ObjectBox.InternalBox(), work with the same map[string]interface{} objects as DynamicBox.
+func NewModelFromJSON(data []byte) (*Model, error) {
+	var source jsonModel
+	if err := json.Unmarshal(data, &source); err != nil {
+		return nil, fmt.Errorf("invalid model JSON: %v", err)
+	}
+
+	var schema = &Schema{}
+	var err error
+
+	if schema.LastEntityId, schema.LastEntityUid, err = parseJSONIdUid(source.LastEntityId); err != nil {
+		return nil, fmt.Errorf("invalid model JSON lastEntityId: %v", err)
+	}
+	if schema.LastIndexId, schema.LastIndexUid, err = parseJSONIdUid(source.LastIndexId); err != nil {
+		return nil, fmt.Errorf("invalid model JSON lastIndexId: %v", err)
+	}
+	if schema.LastRelationId, schema.LastRelationUid, err = parseJSONIdUid(source.LastRelationId); err != nil {
+		return nil, fmt.Errorf("invalid model JSON lastRelationId: %v", err)
+	}
+
+	for _, sourceEntity := range source.Entities {
+		var entity = &SchemaEntity{Name: sourceEntity.Name, Flags: sourceEntity.Flags}
+		if entity.Id, entity.Uid, err = parseJSONIdUid(sourceEntity.Id); err != nil {
+			return nil, fmt.Errorf("invalid model JSON entity %s id: %v", sourceEntity.Name, err)
+		}
+		if entity.LastPropertyId, entity.LastPropertyUid, err = parseJSONIdUid(sourceEntity.LastPropertyId); err != nil {
+			return nil, fmt.Errorf("invalid model JSON entity %s lastPropertyId: %v", sourceEntity.Name, err)
+		}
+
+		for _, sourceProperty := range sourceEntity.Properties {
+			var property = &SchemaProperty{
+				Name:           sourceProperty.Name,
+				Type:           PropertyType(sourceProperty.Type),
+				Flags:          sourceProperty.Flags,
+				RelationTarget: sourceProperty.RelationTarget,
+			}
+			if property.Id, property.Uid, err = parseJSONIdUid(sourceProperty.Id); err != nil {
+				return nil, fmt.Errorf("invalid model JSON property %s.%s id: %v", entity.Name, property.Name, err)
+			}
+			if property.IndexId, property.IndexUid, err = parseJSONIdUid(sourceProperty.IndexId); err != nil {
+				return nil, fmt.Errorf("invalid model JSON property %s.%s indexId: %v", entity.Name, property.Name, err)
+			}
+			entity.Properties = append(entity.Properties, property)
+		}
+
+		for _, sourceRelation := range sourceEntity.Relations {
+			var relation = &SchemaRelation{}
+			if relation.Id, relation.Uid, err = parseJSONIdUid(sourceRelation.Id); err != nil {
+				return nil, fmt.Errorf("invalid model JSON relation %s.%s id: %v", entity.Name, sourceRelation.Name, err)
+			}
+			if relation.TargetEntityId, relation.TargetEntityUid, err = parseJSONIdUid(sourceRelation.TargetId); err != nil {
+				return nil, fmt.Errorf("invalid model JSON relation %s.%s targetId: %v", entity.Name, sourceRelation.Name, err)
+			}
+			entity.Relations = append(entity.Relations, relation)
+		}
+
+		schema.Entities = append(schema.Entities, entity)
+	}
+
+	var model = newModelFromSchema(schema)
+	if err := model.validate(); err != nil {
+		return nil, err
+	}
+	return model, nil
+}
+
+// parseJSONIdUid parses the "id:uid" format used in objectbox-model.json; an empty string results in zero values
+func parseJSONIdUid(str string) (TypeId, uint64, error) {
+	if len(str) == 0 {
+		return 0, 0, nil
+	}
+
+	var parts = strings.Split(str, ":")
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("%s doesn't match the expected format ID:UID", str)
+	}
+
+	id, err := strconv.ParseUint(parts[0], 10, 32)
+	if err != nil {
+		return 0, 0, fmt.Errorf("%s contains an invalid ID: %v", str, err)
+	}
+
+	uid, err := strconv.ParseUint(parts[1], 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("%s contains an invalid UID: %v", str, err)
+	}
+
+	return TypeId(id), uid, nil
+}
+
+// newModelFromSchema creates a model without bindings, as if it was declared by generated code.
+// Check model.Error for the result.
+func newModelFromSchema(schema *Schema) *Model {
+	var model = NewModel()
+	model.GeneratorVersion(gogen.VersionId)
+
+	for _, entity := range schema.Entities {
+		model.Entity(entity.Name, entity.Id, entity.Uid)
+		if entity.Flags != 0 {
+			model.EntityFlags(entity.Flags)
+		}
+
+		for _, property := range entity.Properties {
+			model.Property(property.Name, int(property.Type), property.Id, property.Uid)
+			if property.Flags != 0 {
+				model.PropertyFlags(property.Flags)
+			}
+			if len(property.RelationTarget) > 0 {
+				model.PropertyRelation(property.RelationTarget, property.IndexId, property.IndexUid)
+			} else if property.IndexId != 0 {
+				model.PropertyIndex(property.IndexId, property.IndexUid)
+			}
+		}
+
+		if entity.LastPropertyId != 0 {
+			model.EntityLastPropertyId(entity.LastPropertyId, entity.LastPropertyUid)
+		}
+
+		for _, relation := range entity.Relations {
+			model.Relation(relation.Id, relation.Uid, relation.TargetEntityId, relation.TargetEntityUid)
+		}
+
+		model.registerCurrentEntity(nil)
+	}
+
+	if schema.LastEntityId != 0 {
+		model.LastEntityId(schema.LastEntityId, schema.LastEntityUid)
+	}
+	if schema.LastIndexId != 0 {
+		model.LastIndexId(schema.LastIndexId, schema.LastIndexUid)
+	}
+	if schema.LastRelationId != 0 {
+		model.LastRelationId(schema.LastRelationId, schema.LastRelationUid)
+	}
+
+	return model
+}
@@ -1,5 +1,5 @@
 /*
- * Copyright 2018-2021 ObjectBox Ltd. All rights reserved.
+ * Copyright 2018-2025 ObjectBox Ltd. All rights reserved.
  *
  * Licensed under the Apache License, Version 2.0 (the "License");
  * you may not use this file except in compliance with the License.
@@ -65,9 +65,10 @@ type Model struct {
 	cModel *C.OBX_model
 	Error  error
 
-	currentEntity  *entity
-	entitiesById   map[TypeId]*entity
-	entitiesByName map[string]*entity
+	currentEntity   *entity
+	currentProperty *SchemaProperty
+	entitiesById    map[TypeId]*entity
+	entitiesByName  map[string]*entity
 
 	lastEntityId  TypeId
 	lastEntityUid uint64
@@ -79,6 +80,9 @@ type Model struct {
 	lastRelationUid uint64
 
 	generatorVersion int
+
+	// schema is recorded while the model is being built because the C API doesn't provide a way to read it back
+	schema *Schema
 }
 
 // NewModel creates a model
@@ -86,6 +90,7 @@ func NewModel() *Model {
 	var model = &Model{
 		entitiesById:   make(map[TypeId]*entity),
 		entitiesByName: make(map[string]*entity),
+		schema:         &Schema{},
 	}
 
 	model.Error = cCallBool(func() bool {
@@ -113,6 +118,8 @@ func (model *Model) LastEntityId(id TypeId, uid uint64) {
 	}
 	model.lastEntityId = id
 	model.lastEntityUid = uid
+	model.schema.LastEntityId = id
+	model.schema.LastEntityUid = uid
 	C.obx_model_last_entity_id(model.cModel, C.obx_schema_id(id), C.obx_uid(uid))
 }
 
@@ -124,6 +131,8 @@ func (model *Model) LastIndexId(id TypeId, uid uint64) {
 	}
 	model.lastIndexId = id
 	model.lastIndexUid = uid
+	model.schema.LastIndexId = id
+	model.schema.LastIndexUid = uid
 	C.obx_model_last_index_id(model.cModel, C.obx_schema_id(id), C.obx_uid(uid))
 }
 
@@ -135,6 +144,8 @@ func (model *Model) LastRelationId(id TypeId, uid uint64) {
 	}
 	model.lastRelationId = id
 	model.lastRelationUid = uid
+	model.schema.LastRelationId = id
+	model.schema.LastRelationUid = uid
 	C.obx_model_last_relation_id(model.cModel, C.obx_schema_id(id), C.obx_uid(uid))
 }
 
@@ -154,9 +165,11 @@ func (model *Model) Entity(name string, id TypeId, uid uint64) {
 	}
 
 	model.currentEntity = &entity{
-		name: name,
-		id:   id,
+		name:   name,
+		id:     id,
+		schema: &SchemaEntity{Id: id, Uid: uid, Name: name},
 	}
+	model.currentProperty = nil
 }
 
 // EntityFlags configures behavior of entities
@@ -167,6 +180,10 @@ func (model *Model) EntityFlags(entityFlags int) {
 	model.Error = cCall(func() C.obx_err {
 		return C.obx_model_entity_flags(model.cModel, C.uint32_t(entityFlags))
 	})
+
+	if model.Error == nil {
+		model.currentEntity.schema.Flags = entityFlags
+	}
 }
 
 // TODO each Entity-related method (e.g. Property, Relation,...) should check whether currentEntity is not nil
@@ -183,6 +200,15 @@ func (model *Model) Relation(relationId TypeId, relationUid uint64, targetEntity
 	})
 
 	model.currentEntity.hasRelations = true
+
+	if model.Error == nil {
+		model.currentEntity.schema.Relations = append(model.currentEntity.schema.Relations, &SchemaRelation{
+			Id:              relationId,
+			Uid:             relationUid,
+			TargetEntityId:  targetEntityId,
+			TargetEntityUid: targetEntityUid,
+		})
+	}
 }
 
 // EntityLastPropertyId declares a property with the highest ID.
@@ -195,6 +221,11 @@ func (model *Model) EntityLastPropertyId(id TypeId, uid uint64) {
 	model.Error = cCall(func() C.obx_err {
 		return C.obx_model_entity_last_property_id(model.cModel, C.obx_schema_id(id), C.obx_uid(uid))
 	})
+
+	if model.Error == nil {
+		model.currentEntity.schema.LastPropertyId = id
+		model.currentEntity.schema.LastPropertyUid = uid
+	}
 }
 
 // Property creates a property in an Entity
@@ -208,6 +239,11 @@ func (model *Model) Property(name string, propertyType int, id TypeId, uid uint6
 	model.Error = cCall(func() C.obx_err {
 		return C.obx_model_property(model.cModel, cname, C.OBXPropertyType(propertyType), C.obx_schema_id(id), C.obx_uid(uid))
 	})
+
+	if model.Error == nil {
+		model.currentProperty = &SchemaProperty{Id: id, Uid: uid, Name: name, Type: PropertyType(propertyType)}
+		model.currentEntity.schema.Properties = append(model.currentEntity.schema.Properties, model.currentProperty)
+	}
 }
 
 // PropertyFlags configures type and other information about the property
@@ -218,6 +254,10 @@ func (model *Model) PropertyFlags(propertyFlags int) {
 	model.Error = cCall(func() C.obx_err {
 		return C.obx_model_property_flags(model.cModel, C.uint32_t(propertyFlags))
 	})
+
+	if model.Error == nil {
+		model.currentProperty.Flags = propertyFlags
+	}
 }
 
 // PropertyIndex creates a new index on the property
@@ -228,6 +268,11 @@ func (model *Model) PropertyIndex(id TypeId, uid uint64) {
 	model.Error = cCall(func() C.obx_err {
 		return C.obx_model_property_index_id(model.cModel, C.obx_schema_id(id), C.obx_uid(uid))
 	})
+
+	if model.Error == nil {
+		model.currentProperty.IndexId = id
+		model.currentProperty.IndexUid = uid
+	}
 }
 
 // PropertyRelation adds a property-based (i.e. to-one) relation
@@ -244,6 +289,12 @@ func (model *Model) PropertyRelation(targetEntityName string, indexId TypeId, in
 	})
 
 	model.currentEntity.hasRelations = true
+
+	if model.Error == nil {
+		model.currentProperty.IndexId = indexId
+		model.currentProperty.IndexUid = indexUid
+		model.currentProperty.RelationTarget = targetEntityName
+	}
 }
 
 // RegisterBinding attaches generated binding code to the model.
@@ -254,14 +305,36 @@ func (model *Model) RegisterBinding(binding ObjectBinding) {
 	}
 
 	model.currentEntity = nil
+	model.currentProperty = nil
 
 	binding.AddToModel(model)
 
+	if model.Error != nil {
+		return
+	}
+
 	if model.currentEntity == nil {
 		model.Error = fmt.Errorf("invalid binding - model.Entity() not called")
 		return
 	}
 
+	var version = binding.GeneratorVersion()
+	if version != gogen.VersionId {
+		model.Error = fmt.Errorf("incompatible generator version %d used to generate the binding %s code "+
+			"- please follow the upgrade procedure described in the README.md", version, model.currentEntity.name)
+		return
+	}
+
+	model.registerCurrentEntity(binding)
+}
+
+// registerCurrentEntity finishes the entity declared by the last model.Entity() call.
+// The binding may be nil for models created without generated code, e.g. NewModelFromJSON().
+func (model *Model) registerCurrentEntity(binding ObjectBinding) {
+	if model.Error != nil {
+		return
+	}
+
 	id := model.currentEntity.id
 	name := model.currentEntity.name
 
@@ -285,18 +358,13 @@ func (model *Model) RegisterBinding(binding ObjectBinding) {
 		return
 	}
 
-	var version = binding.GeneratorVersion()
-	if version != gogen.VersionId {
-		model.Error = fmt.Errorf("incompatible generator version %d used to generate the binding %s code "+
-			"- please follow the upgrade procedure described in the README.md", version, name)
-		return
-	}
-
 	model.currentEntity.binding = binding
 	model.entitiesById[id] = model.currentEntity
 	model.entitiesByName[name] = model.currentEntity
+	model.schema.Entities = append(model.schema.Entities, model.currentEntity.schema)
 
 	model.currentEntity = nil
+	model.currentProperty = nil
 }
 
 func (model *Model) validate() error {
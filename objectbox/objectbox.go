@@ -24,6 +24,7 @@ package objectbox
 import "C"
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"runtime"
@@ -80,16 +81,32 @@ type ObjectBox struct {
 	boxesMutex     sync.Mutex
 	options        options
 	syncClient     *SyncClient
+	logCallbackId  cCallbackId
+	schema         *Schema
+
+	// native calls on query clones, possibly abandoned by the caller, see cCallContext(); Close() waits for them
+	contextCalls sync.WaitGroup
+
+	// number of transactions active on each OS thread (by its ID), see inTx()
+	txThreads      map[uint64]int
+	txThreadsMutex sync.Mutex
 }
 
 type options struct {
 	asyncTimeout uint
+	readOnly     bool
 }
 
+// ErrReadOnly is returned by write operations on a store opened using Builder.ReadOnly(); it matches ErrIllegalState,
+// while other illegal-state errors don't match ErrReadOnly
+var ErrReadOnly error = newIllegalStateError("the store was opened in read-only mode")
+
 // constant during runtime so no need to call this each time it's necessary
 var supportsResultArray = bool(C.obx_has_feature(C.OBXFeature_ResultArray))
 
-// Close fully closes the database and frees resources
+// Close fully closes the database and frees resources.
+// Native query calls abandoned by the *Context() methods (e.g. Query.CountContext()) are awaited before the store is
+// closed.
 func (ob *ObjectBox) Close() {
 	storeToClose := ob.store
 	ob.store = nil
@@ -97,8 +114,14 @@ func (ob *ObjectBox) Close() {
 		_ = ob.syncClient.Close()
 	}
 	if storeToClose != nil {
+		// queries must not outlive the store
+		ob.contextCalls.Wait()
 		C.obx_store_close(storeToClose)
 	}
+
+	// after the store is closed, there can be no more log messages
+	cCallbackUnregister(ob.logCallbackId)
+	ob.logCallbackId = 0
 }
 
 // RunInReadTx executes the given function inside a read transaction.
@@ -107,7 +130,13 @@ func (ob *ObjectBox) Close() {
 // Multiple read transaction may be executed concurrently.
 // The error returned by your callback is passed-through as the output error
 func (ob *ObjectBox) RunInReadTx(fn func() error) error {
-	return ob.runInTxn(true, fn)
+	return ob.runInTxn(nil, true, fn)
+}
+
+// RunInReadTxContext is like RunInReadTx() but doesn't start the transaction if the context is already done.
+// If the context is done by the time fn returns, the context's error is returned.
+func (ob *ObjectBox) RunInReadTxContext(ctx context.Context, fn func() error) error {
+	return ob.runInTxn(ctx, true, fn)
 }
 
 // RunInWriteTx executes the given function inside a write transaction.
@@ -117,10 +146,30 @@ func (ob *ObjectBox) RunInReadTx(fn func() error) error {
 // The error returned by your callback is passed-through as the output error.
 // If the resulting error is not nil, the transaction is aborted (rolled-back)
 func (ob *ObjectBox) RunInWriteTx(fn func() error) error {
-	return ob.runInTxn(false, fn)
+	return ob.runInTxn(nil, false, fn)
 }
 
-func (ob *ObjectBox) runInTxn(readOnly bool, fn func() error) (err error) {
+// RunInWriteTxContext is like RunInWriteTx() but doesn't start the transaction if the context is already done.
+// If the context is done by the time fn returns, the transaction is rolled back and the context's error is returned.
+// Use the context inside fn as well, e.g. with Box.PutManyContext(), to stop long-running operations early.
+func (ob *ObjectBox) RunInWriteTxContext(ctx context.Context, fn func() error) error {
+	return ob.runInTxn(ctx, false, fn)
+}
+
+// runInTxn implements RunIn*Tx() and RunIn*TxContext(); ctx is optional (may be nil)
+func (ob *ObjectBox) runInTxn(ctx context.Context, readOnly bool, fn func() error) (err error) {
+	if !readOnly {
+		if err = ob.checkWritable(); err != nil {
+			return err
+		}
+	}
+
+	if ctx != nil {
+		if err = ctx.Err(); err != nil {
+			return err
+		}
+	}
+
 	// NOTE if runtime.LockOSThread() is about to be removed, evaluate use of createError() inside transactions
 	runtime.LockOSThread()
 
@@ -137,8 +186,12 @@ func (ob *ObjectBox) runInTxn(readOnly bool, fn func() error) (err error) {
 		return err
 	}
 
+	var threadId = ob.txStarted()
+
 	// Defer to ensure a TX is ALWAYS closed, even in a panic
 	defer func() {
+		ob.txFinished(threadId)
+
 		if cTxn != nil {
 			if rc := C.obx_txn_close(cTxn); rc != 0 {
 				if err == nil {
@@ -154,6 +207,10 @@ func (ob *ObjectBox) runInTxn(readOnly bool, fn func() error) (err error) {
 
 	err = fn()
 
+	if ctx != nil && err == nil {
+		err = ctx.Err()
+	}
+
 	if !readOnly && err == nil {
 		var ptr = cTxn
 		cTxn = nil
@@ -165,6 +222,30 @@ func (ob *ObjectBox) runInTxn(readOnly bool, fn func() error) (err error) {
 	return err
 }
 
+// IsReadOnly returns true if the store was opened in read-only mode, see Builder.ReadOnly()
+func (ob *ObjectBox) IsReadOnly() bool {
+	return ob.options.readOnly
+}
+
+// OpenedWithPreviousCommit returns true if the store was opened with the previous commit (data snapshot) instead of the
+// latest one, see Builder.UsePreviousCommit().
+func (ob *ObjectBox) OpenedWithPreviousCommit() bool {
+	return bool(C.obx_store_opened_with_previous_commit(ob.store))
+}
+
+// checkWritable makes write operations fail fast (before calling into the core) when in read-only mode
+func (ob *ObjectBox) checkWritable() error {
+	if ob.options.readOnly {
+		return ErrReadOnly
+	}
+	return nil
+}
+
+// Schema returns a read-only description of the data model (entities, properties, relations) the store was opened with
+func (ob *ObjectBox) Schema() *Schema {
+	return ob.schema
+}
+
 func (ob *ObjectBox) getEntityById(id TypeId) *entity {
 	entity := ob.entitiesById[id]
 	if entity == nil {
@@ -225,6 +306,36 @@ func (ob *ObjectBox) AwaitAsyncCompletion() error {
 	})
 }
 
+// AwaitAsyncSubmitted blocks until previously submitted async operations have been processed; as opposed to
+// AwaitAsyncCompletion(), it doesn't wait for operations submitted after this call (the queue doesn't have to become idle).
+func (ob *ObjectBox) AwaitAsyncSubmitted() error {
+	return cCallBool(func() bool {
+		return bool(C.obx_store_await_async_submitted(ob.store))
+	})
+}
+
+// AwaitAsyncCompletionContext is like AwaitAsyncCompletion() but returns the context's error as soon as the context is
+// done, e.g. when its deadline is exceeded. The async queue itself isn't affected by the cancellation.
+func (ob *ObjectBox) AwaitAsyncCompletionContext(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	// not tracked by ObjectBox.Close(), which makes the wait return by closing the store
+	return cCallContext(ctx, nil, ob.AwaitAsyncCompletion)
+}
+
+// AwaitAsyncSubmittedContext is like AwaitAsyncSubmitted() but returns the context's error as soon as the context is
+// done, e.g. when its deadline is exceeded. The async queue itself isn't affected by the cancellation.
+func (ob *ObjectBox) AwaitAsyncSubmittedContext(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	// not tracked by ObjectBox.Close(), which makes the wait return by closing the store
+	return cCallContext(ctx, nil, ob.AwaitAsyncSubmitted)
+}
+
 // SyncClient returns an existing client associated with the store or nil if not available.
 // Use NewSyncClient() to create it the first time.
 func (ob *ObjectBox) SyncClient() (*SyncClient, error) {
@@ -0,0 +1,305 @@
+/*
+ * Copyright 2018-2025 ObjectBox Ltd. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package objectbox
+
+/*
+#include "objectbox.h"
+*/
+import "C"
+
+import (
+	"fmt"
+	"runtime"
+	"unsafe"
+
+	flatbuffers "github.com/google/flatbuffers/go"
+)
+
+// PropertySetter assigns a value to a property, see Box.UpdateProperties() and Query.Update().
+// Use the Set() methods of the property types to create one, e.g. Person_.Name.Set("Joe").
+// Note: the value is the one stored in the database, i.e. for properties using a converter, pass the converted value.
+type PropertySetter struct {
+	property *BaseProperty
+	value    interface{} // nil to remove the value
+}
+
+// SetNil creates a setter removing the value of the property, i.e. it will be read as nil (or a zero value)
+func (property *BaseProperty) SetNil() PropertySetter {
+	return PropertySetter{property: property}
+}
+
+// Set creates a setter assigning the given value, see Box.UpdateProperties()
+func (property PropertyString) Set(value string) PropertySetter {
+	return PropertySetter{property: property.BaseProperty, value: value}
+}
+
+// Set creates a setter assigning the given value, see Box.UpdateProperties()
+func (property PropertyStringVector) Set(value []string) PropertySetter {
+	return PropertySetter{property: property.BaseProperty, value: value}
+}
+
+// Set creates a setter assigning the given value, see Box.UpdateProperties()
+func (property PropertyInt64) Set(value int64) PropertySetter {
+	return PropertySetter{property: property.BaseProperty, value: value}
+}
+
+// Set creates a setter assigning the given value, see Box.UpdateProperties()
+func (property PropertyInt) Set(value int) PropertySetter {
+	return PropertySetter{property: property.BaseProperty, value: value}
+}
+
+// Set creates a setter assigning the given value, see Box.UpdateProperties()
+func (property PropertyUint64) Set(value uint64) PropertySetter {
+	return PropertySetter{property: property.BaseProperty, value: value}
+}
+
+// Set creates a setter assigning the given value, see Box.UpdateProperties()
+func (property PropertyUint) Set(value uint) PropertySetter {
+	return PropertySetter{property: property.BaseProperty, value: value}
+}
+
+// Set creates a setter assigning the given value, see Box.UpdateProperties()
+func (property PropertyRune) Set(value rune) PropertySetter {
+	return PropertySetter{property: property.BaseProperty, value: value}
+}
+
+// Set creates a setter assigning the given value, see Box.UpdateProperties()
+func (property PropertyInt32) Set(value int32) PropertySetter {
+	return PropertySetter{property: property.BaseProperty, value: value}
+}
+
+// Set creates a setter assigning the given value, see Box.UpdateProperties()
+func (property PropertyUint32) Set(value uint32) PropertySetter {
+	return PropertySetter{property: property.BaseProperty, value: value}
+}
+
+// Set creates a setter assigning the given value, see Box.UpdateProperties()
+func (property PropertyInt16) Set(value int16) PropertySetter {
+	return PropertySetter{property: property.BaseProperty, value: value}
+}
+
+// Set creates a setter assigning the given value, see Box.UpdateProperties()
+func (property PropertyUint16) Set(value uint16) PropertySetter {
+	return PropertySetter{property: property.BaseProperty, value: value}
+}
+
+// Set creates a setter assigning the given value, see Box.UpdateProperties()
+func (property PropertyInt8) Set(value int8) PropertySetter {
+	return PropertySetter{property: property.BaseProperty, value: value}
+}
+
+// Set creates a setter assigning the given value, see Box.UpdateProperties()
+func (property PropertyUint8) Set(value uint8) PropertySetter {
+	return PropertySetter{property: property.BaseProperty, value: value}
+}
+
+// Set creates a setter assigning the given value, see Box.UpdateProperties()
+func (property PropertyByte) Set(value byte) PropertySetter {
+	return PropertySetter{property: property.BaseProperty, value: value}
+}
+
+// Set creates a setter assigning the given value, see Box.UpdateProperties()
+func (property PropertyFloat64) Set(value float64) PropertySetter {
+	return PropertySetter{property: property.BaseProperty, value: value}
+}
+
+// Set creates a setter assigning the given value, see Box.UpdateProperties()
+func (property PropertyFloat32) Set(value float32) PropertySetter {
+	return PropertySetter{property: property.BaseProperty, value: value}
+}
+
+// Set creates a setter assigning the given value, see Box.UpdateProperties()
+func (property PropertyByteVector) Set(value []byte) PropertySetter {
+	return PropertySetter{property: property.BaseProperty, value: value}
+}
+
+// Set creates a setter assigning the given value, see Box.UpdateProperties()
+func (property PropertyBool) Set(value bool) PropertySetter {
+	return PropertySetter{property: property.BaseProperty, value: value}
+}
+
+// UpdateProperties changes the given properties of a stored object, without the need to read and put the whole object.
+// All setters are applied in a single write transaction, so concurrent changes to other properties aren't lost, e.g.
+//
+//	err := box.UpdateProperties(id, Person_.Name.Set("Joe"), Person_.Age.Set(42))
+//
+// Returns an error matching ErrNotFound if there's no object with the given ID.
+// Note: objects with vector (other than []byte and []string) or flex properties are not supported.
+// Because the typed object isn't loaded, BeforePut and AfterPut hooks are not invoked, see BeforePutHook.
+func (box *Box) UpdateProperties(id uint64, setters ...PropertySetter) error {
+	if err := box.checkWritable(); err != nil {
+		return err
+	}
+
+	updater, err := box.newPropertyUpdater(setters)
+	if err != nil {
+		return err
+	}
+
+	return box.ObjectBox.RunInWriteTx(func() error {
+		if found, err := updater.update(id); err != nil {
+			return err
+		} else if !found {
+			return &Error{Code: C.OBX_ERROR_ID_NOT_FOUND,
+				Message: fmt.Sprintf("cannot update properties of object with ID %d - it doesn't exist", id)}
+		}
+		return nil
+	})
+}
+
+// Update changes the given properties of all objects matching the query in a single write transaction.
+// Returns the number of updated objects. See Box.UpdateProperties() for details.
+func (query *Query) Update(setters ...PropertySetter) (count uint64, err error) {
+	defer runtime.KeepAlive(query)
+
+	if err := query.check(); err != nil {
+		return 0, err
+	}
+
+	if err := query.box.checkWritable(); err != nil {
+		return 0, err
+	}
+
+	updater, err := query.box.newPropertyUpdater(setters)
+	if err != nil {
+		return 0, err
+	}
+
+	err = query.box.ObjectBox.RunInWriteTx(func() error {
+		if err := query.updateRelationCounts(query.cQuery); err != nil {
+			return err
+		}
+
+		ids, err := cGetIds(func() *C.OBX_id_array {
+			return C.obx_query_find_ids(query.cQuery)
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, id := range ids {
+			if found, err := updater.update(id); err != nil {
+				return err
+			} else if found {
+				count++
+			}
+		}
+		return nil
+	})
+
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// propertyUpdater applies setters to the stored FlatBuffers data using the schema-based (dynamic) binding
+type propertyUpdater struct {
+	box     *Box
+	binding *dynamicBinding
+	values  map[string]interface{}
+}
+
+func (box *Box) newPropertyUpdater(setters []PropertySetter) (*propertyUpdater, error) {
+	var schema = box.entity.schema
+
+	// all properties are read and written again so they must all be supported, otherwise data would be lost
+	for _, property := range schema.Properties {
+		if !dynamicSupportsType(property.Type) {
+			return nil, fmt.Errorf("updating properties of entity %s is not supported: property %s has type %d",
+				schema.Name, property.Name, property.Type)
+		}
+	}
+
+	var updater = &propertyUpdater{
+		box:     box,
+		binding: &dynamicBinding{schema: schema, idProperty: schema.IdProperty()},
+		values:  make(map[string]interface{}, len(setters)),
+	}
+
+	for _, property := range schema.Properties {
+		if int(property.Id) > updater.binding.fieldCount {
+			updater.binding.fieldCount = int(property.Id)
+		}
+	}
+
+	for _, setter := range setters {
+		if setter.property.Entity.Id != schema.Id {
+			return nil, fmt.Errorf("property from a different entity %d passed, expected %d",
+				setter.property.Entity.Id, schema.Id)
+		}
+
+		var property = schema.PropertyById(setter.property.Id)
+		if property == nil {
+			return nil, fmt.Errorf("unknown property ID %d in entity %s", setter.property.Id, schema.Name)
+		} else if property == updater.binding.idProperty {
+			return nil, fmt.Errorf("can't change the ID property %s.%s", schema.Name, property.Name)
+		}
+		updater.values[property.Name] = setter.value
+	}
+
+	return updater, nil
+}
+
+// update must be called inside a write transaction; returns false if the object doesn't exist
+func (updater *propertyUpdater) update(id uint64) (bool, error) {
+	var box = updater.box
+
+	data, err := box.getRaw(id)
+	if err != nil || data == nil {
+		return false, err
+	}
+
+	// Load() copies all values so the data may be overwritten afterwards
+	object, err := updater.binding.Load(box.ObjectBox, data)
+	if err != nil {
+		return false, err
+	}
+
+	var values = object.(map[string]interface{})
+
+	// increment the version, see Model.VersionProperty(); an explicitly set value takes precedence below
+	if property := box.entity.versionProperty; property != nil {
+		version, err := objectVersion(values, property)
+		if err != nil {
+			return false, err
+		}
+		values[property.Name] = version + 1
+	}
+
+	for name, value := range updater.values {
+		if value == nil {
+			delete(values, name)
+		} else {
+			values[name] = value
+		}
+	}
+
+	var fbb = flatbuffers.NewBuilder(len(data))
+	if err := updater.binding.Flatten(values, fbb, id); err != nil {
+		return false, err
+	}
+	fbb.Finish(fbb.EndObject())
+	var bytes = fbb.FinishedBytes()
+
+	if err := cCall(func() C.obx_err {
+		return C.obx_box_put5(box.cBox, C.obx_id(id), unsafe.Pointer(&bytes[0]), C.size_t(len(bytes)), cPutModeUpdate)
+	}); err != nil {
+		return false, box.uniqueViolationDetails(err, []uint64{id}, [][]byte{bytes})
+	}
+	return true, nil
+}
@@ -22,6 +22,7 @@ package objectbox
 */
 import "C"
 import (
+	"context"
 	"fmt"
 	"runtime"
 	"sync"
@@ -33,10 +34,12 @@ type PropertyQuery struct {
 	cPropQuery *C.OBX_query_prop
 	closeMutex sync.Mutex
 	query      *Query
+	propertyId TypeId
+	distinct   func(cPropQuery *C.OBX_query_prop) C.obx_err // the last distinct configuration, applied to clones
 }
 
 func newPropertyQuery(query *Query, propertyId TypeId) (*PropertyQuery, error) {
-	var pq = &PropertyQuery{query: query}
+	var pq = &PropertyQuery{query: query, propertyId: propertyId}
 
 	if err := cCallBool(func() bool {
 		pq.cPropQuery = C.obx_query_prop(query.cQuery, C.obx_schema_id(propertyId))
@@ -78,21 +81,52 @@ func propQueryFinalizer(pq *PropertyQuery) {
 // Distinct configures the property query to work only on distinct values.
 // Note: not all methods support distinct, those that don't will return an error.
 func (pq *PropertyQuery) Distinct(value bool) error {
-	return cCall(func() C.obx_err {
-		return C.obx_query_prop_distinct(pq.cPropQuery, C.bool(value))
+	return pq.setDistinct(func(cPropQuery *C.OBX_query_prop) C.obx_err {
+		return C.obx_query_prop_distinct(cPropQuery, C.bool(value))
 	})
 }
 
 // DistinctString configures the property query to work only on distinct values.
 // Note: not all methods support distinct, those that don't will return an error.
 func (pq *PropertyQuery) DistinctString(value, caseSensitive bool) error {
-	return cCall(func() C.obx_err {
-		return C.obx_query_prop_distinct_case(pq.cPropQuery, C.bool(value), C.bool(caseSensitive))
+	return pq.setDistinct(func(cPropQuery *C.OBX_query_prop) C.obx_err {
+		return C.obx_query_prop_distinct_case(cPropQuery, C.bool(value), C.bool(caseSensitive))
 	})
 }
 
+func (pq *PropertyQuery) setDistinct(fn func(cPropQuery *C.OBX_query_prop) C.obx_err) error {
+	if err := cCall(func() C.obx_err { return fn(pq.cPropQuery) }); err != nil {
+		return err
+	}
+	pq.distinct = fn
+	return nil
+}
+
+// check verifies the property query wasn't closed
+func (pq *PropertyQuery) check() error {
+	if pq.cPropQuery == nil {
+		return newIllegalStateError("property query was closed")
+	}
+	return nil
+}
+
+// prepare checks the property query and prepares its query for execution, see Query.prepareRelationCounts().
+// The returned function must be called after the property query was executed.
+func (pq *PropertyQuery) prepare() (finish func(), err error) {
+	if err := pq.check(); err != nil {
+		return nil, err
+	}
+	return pq.query.prepareRelationCounts(pq.query.cQuery)
+}
+
 // Count returns a number of non-NULL values of the given property across all objects matching the query.
 func (pq *PropertyQuery) Count() (uint64, error) {
+	finish, err := pq.prepare()
+	if err != nil {
+		return 0, err
+	}
+	defer finish()
+
 	var cResult C.uint64_t
 	if err := cCall(func() C.obx_err { return C.obx_query_prop_count(pq.cPropQuery, &cResult) }); err != nil {
 		return 0, err
@@ -102,6 +136,12 @@ func (pq *PropertyQuery) Count() (uint64, error) {
 
 // Average returns an average value for the given numeric property across all objects matching the query.
 func (pq *PropertyQuery) Average() (float64, error) {
+	finish, err := pq.prepare()
+	if err != nil {
+		return 0, err
+	}
+	defer finish()
+
 	var cResult C.double
 	var cCount C.int64_t
 	if err := cCall(func() C.obx_err { return C.obx_query_prop_avg(pq.cPropQuery, &cResult, &cCount) }); err != nil {
@@ -112,6 +152,12 @@ func (pq *PropertyQuery) Average() (float64, error) {
 
 // MinFloat64 finds the minimum value of the given floating-point property across all objects matching the query.
 func (pq *PropertyQuery) MinFloat64() (float64, error) {
+	finish, err := pq.prepare()
+	if err != nil {
+		return 0, err
+	}
+	defer finish()
+
 	var cResult C.double
 	if err := cCall(func() C.obx_err { return C.obx_query_prop_min(pq.cPropQuery, &cResult, nil) }); err != nil {
 		return 0, err
@@ -121,6 +167,12 @@ func (pq *PropertyQuery) MinFloat64() (float64, error) {
 
 // MaxFloat64 finds the maximum value of the given floating-point property across all objects matching the query.
 func (pq *PropertyQuery) MaxFloat64() (float64, error) {
+	finish, err := pq.prepare()
+	if err != nil {
+		return 0, err
+	}
+	defer finish()
+
 	var cResult C.double
 	if err := cCall(func() C.obx_err { return C.obx_query_prop_max(pq.cPropQuery, &cResult, nil) }); err != nil {
 		return 0, err
@@ -130,6 +182,12 @@ func (pq *PropertyQuery) MaxFloat64() (float64, error) {
 
 // SumFloat64 calculates the sum of the given floating-point property across all objects matching the query.
 func (pq *PropertyQuery) SumFloat64() (float64, error) {
+	finish, err := pq.prepare()
+	if err != nil {
+		return 0, err
+	}
+	defer finish()
+
 	var cResult C.double
 	if err := cCall(func() C.obx_err { return C.obx_query_prop_sum(pq.cPropQuery, &cResult, nil) }); err != nil {
 		return 0, err
@@ -139,6 +197,12 @@ func (pq *PropertyQuery) SumFloat64() (float64, error) {
 
 // Min finds the minimum value of the given property across all objects matching the query.
 func (pq *PropertyQuery) Min() (int64, error) {
+	finish, err := pq.prepare()
+	if err != nil {
+		return 0, err
+	}
+	defer finish()
+
 	var cResult C.int64_t
 	if err := cCall(func() C.obx_err { return C.obx_query_prop_min_int(pq.cPropQuery, &cResult, nil) }); err != nil {
 		return 0, err
@@ -148,6 +212,12 @@ func (pq *PropertyQuery) Min() (int64, error) {
 
 // Max finds the maximum value of the given property across all objects matching the query.
 func (pq *PropertyQuery) Max() (int64, error) {
+	finish, err := pq.prepare()
+	if err != nil {
+		return 0, err
+	}
+	defer finish()
+
 	var cResult C.int64_t
 	if err := cCall(func() C.obx_err { return C.obx_query_prop_max_int(pq.cPropQuery, &cResult, nil) }); err != nil {
 		return 0, err
@@ -157,6 +227,12 @@ func (pq *PropertyQuery) Max() (int64, error) {
 
 // Sum calculates the sum of the given property across all objects matching the query.
 func (pq *PropertyQuery) Sum() (int64, error) {
+	finish, err := pq.prepare()
+	if err != nil {
+		return 0, err
+	}
+	defer finish()
+
 	var cResult C.int64_t
 	if err := cCall(func() C.obx_err { return C.obx_query_prop_sum_int(pq.cPropQuery, &cResult, nil) }); err != nil {
 		return 0, err
@@ -168,6 +244,12 @@ func (pq *PropertyQuery) Sum() (int64, error) {
 // Parameter valueIfNil - value that should be returned instead of NULL values on object fields.
 // If `valueIfNil = nil` is given, objects with NULL values of the specified field are skipped.
 func (pq *PropertyQuery) FindInts(valueIfNil *int) ([]int, error) {
+	finish, err := pq.prepare()
+	if err != nil {
+		return nil, err
+	}
+	defer finish()
+
 	return cGetInts(func() *C.OBX_int64_array {
 		if valueIfNil == nil {
 			return C.obx_query_prop_find_int64s(pq.cPropQuery, nil)
@@ -182,6 +264,12 @@ func (pq *PropertyQuery) FindInts(valueIfNil *int) ([]int, error) {
 // Parameter valueIfNil - value that should be returned instead of NULL values on object fields.
 // If `valueIfNil = nil` is given, objects with NULL values of the specified field are skipped.
 func (pq *PropertyQuery) FindUints(valueIfNil *uint) ([]uint, error) {
+	finish, err := pq.prepare()
+	if err != nil {
+		return nil, err
+	}
+	defer finish()
+
 	return cGetUints(func() *C.OBX_int64_array {
 		if valueIfNil == nil {
 			return C.obx_query_prop_find_int64s(pq.cPropQuery, nil)
@@ -196,6 +284,12 @@ func (pq *PropertyQuery) FindUints(valueIfNil *uint) ([]uint, error) {
 // Parameter valueIfNil - value that should be returned instead of NULL values on object fields.
 // If `valueIfNil = nil` is given, objects with NULL values of the specified field are skipped.
 func (pq *PropertyQuery) FindInt64s(valueIfNil *int64) ([]int64, error) {
+	finish, err := pq.prepare()
+	if err != nil {
+		return nil, err
+	}
+	defer finish()
+
 	return cGetInt64s(func() *C.OBX_int64_array {
 		return C.obx_query_prop_find_int64s(pq.cPropQuery, (*C.int64_t)(valueIfNil))
 	})
@@ -205,6 +299,12 @@ func (pq *PropertyQuery) FindInt64s(valueIfNil *int64) ([]int64, error) {
 // Parameter valueIfNil - value that should be returned instead of NULL values on object fields.
 // If `valueIfNil = nil` is given, objects with NULL values of the specified field are skipped.
 func (pq *PropertyQuery) FindUint64s(valueIfNil *uint64) ([]uint64, error) {
+	finish, err := pq.prepare()
+	if err != nil {
+		return nil, err
+	}
+	defer finish()
+
 	return cGetUint64s(func() *C.OBX_int64_array {
 		if valueIfNil == nil {
 			return C.obx_query_prop_find_int64s(pq.cPropQuery, nil)
@@ -219,6 +319,12 @@ func (pq *PropertyQuery) FindUint64s(valueIfNil *uint64) ([]uint64, error) {
 // Parameter valueIfNil - value that should be returned instead of NULL values on object fields.
 // If `valueIfNil = nil` is given, objects with NULL values of the specified field are skipped.
 func (pq *PropertyQuery) FindInt32s(valueIfNil *int32) ([]int32, error) {
+	finish, err := pq.prepare()
+	if err != nil {
+		return nil, err
+	}
+	defer finish()
+
 	return cGetInt32s(func() *C.OBX_int32_array {
 		return C.obx_query_prop_find_int32s(pq.cPropQuery, (*C.int32_t)(valueIfNil))
 	})
@@ -228,6 +334,12 @@ func (pq *PropertyQuery) FindInt32s(valueIfNil *int32) ([]int32, error) {
 // Parameter valueIfNil - value that should be returned instead of NULL values on object fields.
 // If `valueIfNil = nil` is given, objects with NULL values of the specified field are skipped.
 func (pq *PropertyQuery) FindUint32s(valueIfNil *uint32) ([]uint32, error) {
+	finish, err := pq.prepare()
+	if err != nil {
+		return nil, err
+	}
+	defer finish()
+
 	return cGetUint32s(func() *C.OBX_int32_array {
 		if valueIfNil == nil {
 			return C.obx_query_prop_find_int32s(pq.cPropQuery, nil)
@@ -242,6 +354,12 @@ func (pq *PropertyQuery) FindUint32s(valueIfNil *uint32) ([]uint32, error) {
 // Parameter valueIfNil - value that should be returned instead of NULL values on object fields.
 // If `valueIfNil = nil` is given, objects with NULL values of the specified field are skipped.
 func (pq *PropertyQuery) FindInt16s(valueIfNil *int16) ([]int16, error) {
+	finish, err := pq.prepare()
+	if err != nil {
+		return nil, err
+	}
+	defer finish()
+
 	return cGetInt16s(func() *C.OBX_int16_array {
 		return C.obx_query_prop_find_int16s(pq.cPropQuery, (*C.int16_t)(valueIfNil))
 	})
@@ -251,6 +369,12 @@ func (pq *PropertyQuery) FindInt16s(valueIfNil *int16) ([]int16, error) {
 // Parameter valueIfNil - value that should be returned instead of NULL values on object fields.
 // If `valueIfNil = nil` is given, objects with NULL values of the specified field are skipped.
 func (pq *PropertyQuery) FindUint16s(valueIfNil *uint16) ([]uint16, error) {
+	finish, err := pq.prepare()
+	if err != nil {
+		return nil, err
+	}
+	defer finish()
+
 	return cGetUint16s(func() *C.OBX_int16_array {
 		if valueIfNil == nil {
 			return C.obx_query_prop_find_int16s(pq.cPropQuery, nil)
@@ -265,6 +389,12 @@ func (pq *PropertyQuery) FindUint16s(valueIfNil *uint16) ([]uint16, error) {
 // Parameter valueIfNil - value that should be returned instead of NULL values on object fields.
 // If `valueIfNil = nil` is given, objects with NULL values of the specified field are skipped.
 func (pq *PropertyQuery) FindInt8s(valueIfNil *int8) ([]int8, error) {
+	finish, err := pq.prepare()
+	if err != nil {
+		return nil, err
+	}
+	defer finish()
+
 	return cGetInt8s(func() *C.OBX_int8_array {
 		return C.obx_query_prop_find_int8s(pq.cPropQuery, (*C.int8_t)(valueIfNil))
 	})
@@ -274,6 +404,12 @@ func (pq *PropertyQuery) FindInt8s(valueIfNil *int8) ([]int8, error) {
 // Parameter valueIfNil - value that should be returned instead of NULL values on object fields.
 // If `valueIfNil = nil` is given, objects with NULL values of the specified field are skipped.
 func (pq *PropertyQuery) FindUint8s(valueIfNil *uint8) ([]uint8, error) {
+	finish, err := pq.prepare()
+	if err != nil {
+		return nil, err
+	}
+	defer finish()
+
 	return cGetUint8s(func() *C.OBX_int8_array {
 		if valueIfNil == nil {
 			return C.obx_query_prop_find_int8s(pq.cPropQuery, nil)
@@ -288,6 +424,12 @@ func (pq *PropertyQuery) FindUint8s(valueIfNil *uint8) ([]uint8, error) {
 // Parameter valueIfNil - value that should be returned instead of NULL values on object fields.
 // If `valueIfNil = nil` is given, objects with NULL values of the specified field are skipped.
 func (pq *PropertyQuery) FindFloat64s(valueIfNil *float64) ([]float64, error) {
+	finish, err := pq.prepare()
+	if err != nil {
+		return nil, err
+	}
+	defer finish()
+
 	return cGetFloat64s(func() *C.OBX_double_array {
 		return C.obx_query_prop_find_doubles(pq.cPropQuery, (*C.double)(valueIfNil))
 	})
@@ -297,6 +439,12 @@ func (pq *PropertyQuery) FindFloat64s(valueIfNil *float64) ([]float64, error) {
 // Parameter valueIfNil - value that should be returned instead of NULL values on object fields.
 // If `valueIfNil = nil` is given, objects with NULL values of the specified field are skipped.
 func (pq *PropertyQuery) FindFloat32s(valueIfNil *float32) ([]float32, error) {
+	finish, err := pq.prepare()
+	if err != nil {
+		return nil, err
+	}
+	defer finish()
+
 	return cGetFloat32s(func() *C.OBX_float_array {
 		return C.obx_query_prop_find_floats(pq.cPropQuery, (*C.float)(valueIfNil))
 	})
@@ -306,6 +454,12 @@ func (pq *PropertyQuery) FindFloat32s(valueIfNil *float32) ([]float32, error) {
 // Parameter valueIfNil - value that should be returned instead of NULL values on object fields.
 // If `valueIfNil = nil` is given, objects with NULL values of the specified field are skipped.
 func (pq *PropertyQuery) FindBools(valueIfNil *bool) ([]bool, error) {
+	finish, err := pq.prepare()
+	if err != nil {
+		return nil, err
+	}
+	defer finish()
+
 	return cGetBools(func() *C.OBX_int8_array {
 		if valueIfNil == nil {
 			return C.obx_query_prop_find_int8s(pq.cPropQuery, nil)
@@ -323,6 +477,12 @@ func (pq *PropertyQuery) FindBools(valueIfNil *bool) ([]bool, error) {
 // Parameter valueIfNil - value that should be returned instead of NULL values on object fields.
 // If `valueIfNil = nil` is given, objects with NULL values of the specified field are skipped.
 func (pq *PropertyQuery) FindStrings(valueIfNil *string) ([]string, error) {
+	finish, err := pq.prepare()
+	if err != nil {
+		return nil, err
+	}
+	defer finish()
+
 	return cGetStrings(func() *C.OBX_string_array {
 		if valueIfNil == nil {
 			return C.obx_query_prop_find_strings(pq.cPropQuery, nil)
@@ -333,3 +493,128 @@ func (pq *PropertyQuery) FindStrings(valueIfNil *string) ([]string, error) {
 		}
 	})
 }
+
+// CountContext is like Count() but returns the context's error as soon as the context is done.
+// Note: the native query can't be interrupted; it's left to finish in the background and its result is discarded.
+func (pq *PropertyQuery) CountContext(ctx context.Context) (uint64, error) {
+	var result uint64
+	if err := pq.callContext(ctx, func(clone *PropertyQuery) (err error) {
+		result, err = clone.Count()
+		return err
+	}); err != nil {
+		return 0, err
+	}
+	return result, nil
+}
+
+// FindInt64sContext is like FindInt64s() but returns the context's error as soon as the context is done.
+// Note: the native query can't be interrupted; it's left to finish in the background and its result is discarded.
+func (pq *PropertyQuery) FindInt64sContext(ctx context.Context, valueIfNil *int64) ([]int64, error) {
+	var result []int64
+	if err := pq.callContext(ctx, func(clone *PropertyQuery) (err error) {
+		result, err = clone.FindInt64s(valueIfNil)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// FindUint64sContext is like FindUint64s() but returns the context's error as soon as the context is done.
+// Note: the native query can't be interrupted; it's left to finish in the background and its result is discarded.
+func (pq *PropertyQuery) FindUint64sContext(ctx context.Context, valueIfNil *uint64) ([]uint64, error) {
+	var result []uint64
+	if err := pq.callContext(ctx, func(clone *PropertyQuery) (err error) {
+		result, err = clone.FindUint64s(valueIfNil)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// FindFloat64sContext is like FindFloat64s() but returns the context's error as soon as the context is done.
+// Note: the native query can't be interrupted; it's left to finish in the background and its result is discarded.
+func (pq *PropertyQuery) FindFloat64sContext(ctx context.Context, valueIfNil *float64) ([]float64, error) {
+	var result []float64
+	if err := pq.callContext(ctx, func(clone *PropertyQuery) (err error) {
+		result, err = clone.FindFloat64s(valueIfNil)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// FindStringsContext is like FindStrings() but returns the context's error as soon as the context is done.
+// Note: the native query can't be interrupted; it's left to finish in the background and its result is discarded.
+func (pq *PropertyQuery) FindStringsContext(ctx context.Context, valueIfNil *string) ([]string, error) {
+	var result []string
+	if err := pq.callContext(ctx, func(clone *PropertyQuery) (err error) {
+		result, err = clone.FindStrings(valueIfNil)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// callContext runs a native property query call using cCallContext(). Like Query.callContext(), the call is executed on
+// a property query created from a clone of the query, which is closed after the call finishes. Inside a transaction,
+// the call stays on the transaction's goroutine, see Query.callContext().
+func (pq *PropertyQuery) callContext(ctx context.Context, fn func(pq *PropertyQuery) error) error {
+	if pq.query.box.tx != nil || pq.query.objectBox.inTx() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		return fn(pq)
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if err := pq.check(); err != nil {
+		return err
+	}
+
+	var cQueryClone *C.OBX_query
+	if err := cCallBool(func() bool {
+		cQueryClone = C.obx_query_clone(pq.query.cQuery)
+		return cQueryClone != nil
+	}); err != nil {
+		return err
+	}
+
+	var queryClone = &Query{
+		entity:    pq.query.entity,
+		objectBox: pq.query.objectBox,
+		box:       pq.query.box,
+		cQuery:    cQueryClone,
+
+		// relation counts are set on the clone by the property query methods, see prepare()
+		relationCounts: pq.query.relationCounts,
+	}
+	var clone = &PropertyQuery{query: queryClone, propertyId: pq.propertyId}
+	if err := cCallBool(func() bool {
+		clone.cPropQuery = C.obx_query_prop(cQueryClone, C.obx_schema_id(pq.propertyId))
+		return clone.cPropQuery != nil
+	}); err != nil {
+		C.obx_query_close(cQueryClone)
+		return err
+	}
+
+	if pq.distinct != nil {
+		if err := cCall(func() C.obx_err { return pq.distinct(clone.cPropQuery) }); err != nil {
+			C.obx_query_prop_close(clone.cPropQuery)
+			C.obx_query_close(cQueryClone)
+			return err
+		}
+	}
+
+	return cCallContext(ctx, &pq.query.objectBox.contextCalls, func() error {
+		defer C.obx_query_close(cQueryClone)
+		defer C.obx_query_prop_close(clone.cPropQuery)
+		return fn(clone)
+	})
+}
@@ -22,7 +22,7 @@ package objectbox
 */
 import "C"
 import (
-	"errors"
+	"context"
 	"fmt"
 	"runtime"
 	"sync"
@@ -43,6 +43,7 @@ type Query struct {
 	offsetErr       error
 	limitErr        error
 	linkedEntityIds []TypeId
+	relationCounts  []*relationCountParam
 }
 
 // Close frees (native) resources held by this Query.
@@ -78,13 +79,68 @@ func (query *Query) installFinalizer() {
 
 func (query *Query) check() error {
 	if query.cQuery == nil {
-		return errors.New("illegal state; query was closed")
+		return newIllegalStateError("query was closed")
 	} else if query.limitErr != nil {
 		return query.limitErr
 	} else if query.offsetErr != nil {
 		return query.offsetErr
 	}
 
+	return query.box.checkTx(false)
+}
+
+// prepareRelationCounts starts a read transaction and sets the parameters of relation count conditions on the given
+// native query (this one or its clone), so that the counts and the query results come from the same snapshot.
+// The returned function finishes the transaction and must be called after the query was executed.
+// Without relation count conditions, there's nothing to prepare.
+func (query *Query) prepareRelationCounts(cQuery *C.OBX_query) (finish func(), err error) {
+	if len(query.relationCounts) == 0 {
+		return func() {}, nil
+	}
+
+	tx, err := query.objectBox.beginTx(true)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := query.updateRelationCounts(cQuery); err != nil {
+		_ = tx.Close()
+		return nil, err
+	}
+	return func() { _ = tx.Close() }, nil
+}
+
+// updateRelationCounts sets current parameters of all relation count conditions, see QueryBuilder.relationCountIds().
+// Must be called inside a transaction, see prepareRelationCounts().
+func (query *Query) updateRelationCounts(cQuery *C.OBX_query) error {
+	var countsByRelation = make(map[*RelationToOne]map[uint64]uint32)
+	for _, param := range query.relationCounts {
+		var counts, ok = countsByRelation[param.relation]
+		if !ok {
+			var err error
+			if counts, err = query.objectBox.relationBacklinkCounts(param.relation); err != nil {
+				return err
+			}
+			countsByRelation[param.relation] = counts
+		}
+
+		// IDs are never 0 so it doesn't match any object but makes sure the parameter isn't empty
+		var ids = []int64{0}
+		for id, count := range counts {
+			if uint64(count) >= param.threshold {
+				ids = append(ids, int64(id))
+			}
+		}
+
+		var cAlias = C.CString(param.alias)
+		var err = cCall(func() C.obx_err {
+			return C.obx_query_param_alias_int64s(cQuery, cAlias, goInt64ArrayToC(ids), C.size_t(len(ids)))
+		})
+		C.free(unsafe.Pointer(cAlias))
+		if err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -116,6 +172,12 @@ func (query *Query) Find() (objects interface{}, err error) {
 		return nil, err
 	}
 
+	finish, err := query.prepareRelationCounts(query.cQuery)
+	if err != nil {
+		return nil, err
+	}
+	defer finish()
+
 	const existingOnly = true
 	if supportsResultArray {
 		var cFn = func() *C.OBX_bytes_array {
@@ -127,7 +189,55 @@ func (query *Query) Find() (objects interface{}, err error) {
 	var cFn = func(visitorArg unsafe.Pointer) C.obx_err {
 		return C.obx_query_visit(query.cQuery, dataVisitor, visitorArg)
 	}
-	return query.box.readUsingVisitor(existingOnly, cFn)
+	return query.box.readUsingVisitor(nil, existingOnly, cFn)
+}
+
+// FindContext is like Find() but stops visiting the results as soon as the context is done, returning its error.
+func (query *Query) FindContext(ctx context.Context) (objects interface{}, err error) {
+	defer runtime.KeepAlive(query)
+
+	if err := query.check(); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	finish, err := query.prepareRelationCounts(query.cQuery)
+	if err != nil {
+		return nil, err
+	}
+	defer finish()
+
+	// always use the visitor so that the context can be checked after each object
+	const existingOnly = true
+	var cFn = func(visitorArg unsafe.Pointer) C.obx_err {
+		return C.obx_query_visit(query.cQuery, dataVisitor, visitorArg)
+	}
+	return query.box.readUsingVisitor(ctx, existingOnly, cFn)
+}
+
+// ForEach calls fn for each object matching the query, loading the objects one by one, as opposed to Find() which
+// collects all objects in a slice first. Return false from fn to stop the iteration early; returning an error stops
+// the iteration as well and the error is returned by ForEach.
+// fn is called inside a read transaction so it must not write to the database.
+func (query *Query) ForEach(fn func(object interface{}) (bool, error)) error {
+	defer runtime.KeepAlive(query)
+
+	if err := query.check(); err != nil {
+		return err
+	}
+
+	finish, err := query.prepareRelationCounts(query.cQuery)
+	if err != nil {
+		return err
+	}
+	defer finish()
+
+	return query.box.visitObjects(fn, func(visitorArg unsafe.Pointer) C.obx_err {
+		return C.obx_query_visit(query.cQuery, dataVisitor, visitorArg)
+	})
 }
 
 // Offset defines the index of the first object to process (how many objects to skip)
@@ -150,11 +260,36 @@ func (query *Query) FindIds() ([]uint64, error) {
 		return nil, err
 	}
 
+	finish, err := query.prepareRelationCounts(query.cQuery)
+	if err != nil {
+		return nil, err
+	}
+	defer finish()
+
 	return cGetIds(func() *C.OBX_id_array {
 		return C.obx_query_find_ids(query.cQuery)
 	})
 }
 
+// FindIdsContext is like FindIds() but returns the context's error as soon as the context is done.
+// Note: the native query can't be interrupted; it's left to finish in the background and its result is discarded.
+func (query *Query) FindIdsContext(ctx context.Context) ([]uint64, error) {
+	if err := query.check(); err != nil {
+		return nil, err
+	}
+
+	var ids []uint64
+	if err := query.callContext(ctx, func(cQuery *C.OBX_query) (err error) {
+		ids, err = cGetIds(func() *C.OBX_id_array {
+			return C.obx_query_find_ids(cQuery)
+		})
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
 // Count returns the number of objects matching the query.
 // Currently can't be used in combination with Offset().
 func (query *Query) Count() (uint64, error) {
@@ -162,6 +297,12 @@ func (query *Query) Count() (uint64, error) {
 		return 0, err
 	}
 
+	finish, err := query.prepareRelationCounts(query.cQuery)
+	if err != nil {
+		return 0, err
+	}
+	defer finish()
+
 	var cResult C.uint64_t
 	if err := cCall(func() C.obx_err { return C.obx_query_count(query.cQuery, &cResult) }); err != nil {
 		return 0, err
@@ -170,6 +311,66 @@ func (query *Query) Count() (uint64, error) {
 	return uint64(cResult), nil
 }
 
+// CountContext is like Count() but returns the context's error as soon as the context is done.
+// Note: the native query can't be interrupted; it's left to finish in the background and its result is discarded.
+func (query *Query) CountContext(ctx context.Context) (uint64, error) {
+	if err := query.check(); err != nil {
+		return 0, err
+	}
+
+	var cResult C.uint64_t
+	if err := query.callContext(ctx, func(cQuery *C.OBX_query) error {
+		return cCall(func() C.obx_err { return C.obx_query_count(cQuery, &cResult) })
+	}); err != nil {
+		return 0, err
+	}
+	return uint64(cResult), nil
+}
+
+// callContext runs a native query call using cCallContext(). Native queries aren't thread safe so the call is executed
+// on a clone of the query, which is closed after the call finishes; an abandoned call thus never touches this query.
+// Inside a transaction (see Tx.Box() and ObjectBox.RunInReadTx()), the call must stay on the transaction's goroutine
+// to see its (uncommitted) state, so the context is only checked upfront.
+func (query *Query) callContext(ctx context.Context, fn func(cQuery *C.OBX_query) error) error {
+	if query.box.tx != nil || query.objectBox.inTx() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		finish, err := query.prepareRelationCounts(query.cQuery)
+		if err != nil {
+			return err
+		}
+		defer finish()
+
+		return fn(query.cQuery)
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	var cClone *C.OBX_query
+	if err := cCallBool(func() bool {
+		cClone = C.obx_query_clone(query.cQuery)
+		return cClone != nil
+	}); err != nil {
+		return err
+	}
+
+	return cCallContext(ctx, &query.objectBox.contextCalls, func() error {
+		defer C.obx_query_close(cClone)
+
+		finish, err := query.prepareRelationCounts(cClone)
+		if err != nil {
+			return err
+		}
+		defer finish()
+
+		return fn(cClone)
+	})
+}
+
 // Remove permanently deletes all objects matching the query from the database.
 // Currently can't be used in combination with Offset() or Limit().
 func (query *Query) Remove() (count uint64, err error) {
@@ -177,8 +378,39 @@ func (query *Query) Remove() (count uint64, err error) {
 		return 0, err
 	}
 
+	if err := query.box.checkWritable(); err != nil {
+		return 0, err
+	}
+
 	var cResult C.uint64_t
-	if err := cCall(func() C.obx_err { return C.obx_query_remove(query.cQuery, &cResult) }); err != nil {
+	var removeFn = func() error {
+		return cCall(func() C.obx_err { return C.obx_query_remove(query.cQuery, &cResult) })
+	}
+
+	// apply delete policies and count relations in the same transaction, see DeletePolicy and RelationCount
+	if len(query.box.entity.deletePolicies) > 0 || len(query.relationCounts) > 0 {
+		err = query.box.ObjectBox.RunInWriteTx(func() error {
+			if err := query.updateRelationCounts(query.cQuery); err != nil {
+				return err
+			}
+
+			if len(query.box.entity.deletePolicies) > 0 {
+				ids, err := cGetIds(func() *C.OBX_id_array { return C.obx_query_find_ids(query.cQuery) })
+				if err != nil {
+					return err
+				}
+
+				if err := query.box.applyDeletePolicies(ids, removal{}); err != nil {
+					return err
+				}
+			}
+			return removeFn()
+		})
+	} else {
+		err = removeFn()
+	}
+
+	if err != nil {
 		return 0, err
 	}
 
@@ -38,6 +38,9 @@ type QueryBuilder struct {
 	innerBuilders []*QueryBuilder
 	orderFlags    map[TypeId]C.OBXOrderFlags
 
+	// relation count conditions with parameters to be updated before each query execution, see RelationCountLess()
+	relationCounts []*relationCountParam
+
 	// The first error that occurred during a any of the calls on the query builder
 	Err error
 }
@@ -133,6 +136,9 @@ func (qb *QueryBuilder) Build(box *Box) (*Query, error) {
 	// search all inner builders recursively and collect linked entity IDs
 	qb.setQueryLinkedEntityIds(query)
 
+	// same for relation count conditions
+	qb.setQueryRelationCounts(query)
+
 	return query, nil
 }
 
@@ -143,6 +149,13 @@ func (qb *QueryBuilder) setQueryLinkedEntityIds(query *Query) {
 	}
 }
 
+func (qb *QueryBuilder) setQueryRelationCounts(query *Query) {
+	query.relationCounts = append(query.relationCounts, qb.relationCounts...)
+	for _, iqb := range qb.innerBuilders {
+		iqb.setQueryRelationCounts(query)
+	}
+}
+
 func (qb *QueryBuilder) applyConditions(conditions []Condition) error {
 	if qb.Err != nil {
 		return qb.Err
@@ -222,6 +235,56 @@ func (qb *QueryBuilder) LinkManyToMany(relation *RelationToMany, conditions []Co
 	return iqb.applyConditions(conditions)
 }
 
+// BacklinkOneToMany is called internally
+func (qb *QueryBuilder) BacklinkOneToMany(relation *RelationToOne, conditions []Condition) error {
+	if qb.Err != nil {
+		return qb.Err
+	}
+
+	// unlike LinkOneToMany(), the direction is given explicitly so this also works for relations to the same entity
+	if relation.Target.Id != qb.typeId {
+		return fmt.Errorf("relation backlink not applicable: the relation targets entity %d, the query is for %d",
+			relation.Target.Id, qb.typeId)
+	}
+
+	// for native calls/createError() in newInnerBuilder
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	cInnerQB := C.obx_qb_backlink_property(qb.cqb, C.obx_schema_id(relation.Property.Entity.Id),
+		C.obx_schema_id(relation.Property.Id))
+	iqb := qb.newInnerBuilder(relation.Property.Entity.Id, cInnerQB)
+	if iqb == nil {
+		return qb.Err // this has been set by newInnerBuilder()
+	}
+
+	return iqb.applyConditions(conditions)
+}
+
+// BacklinkManyToMany is called internally
+func (qb *QueryBuilder) BacklinkManyToMany(relation *RelationToMany, conditions []Condition) error {
+	if qb.Err != nil {
+		return qb.Err
+	}
+
+	// unlike LinkManyToMany(), the direction is given explicitly so this also works for relations to the same entity
+	if relation.Target.Id != qb.typeId {
+		return fmt.Errorf("relation backlink not applicable: the relation targets entity %d, the query is for %d",
+			relation.Target.Id, qb.typeId)
+	}
+
+	// for native calls/createError() in newInnerBuilder
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	iqb := qb.newInnerBuilder(relation.Source.Id, C.obx_qb_backlink_standalone(qb.cqb, C.obx_schema_id(relation.Id)))
+	if iqb == nil {
+		return qb.Err // this has been set by newInnerBuilder()
+	}
+
+	return iqb.applyConditions(conditions)
+}
+
 func (qb *QueryBuilder) order(propertyId C.obx_schema_id, flags C.OBXOrderFlags) {
 	if qb.Err == nil {
 		qb.Err = cCall(func() C.obx_err {
@@ -340,6 +403,97 @@ func (qb *QueryBuilder) All(ids []ConditionId) (ConditionId, error) {
 	return cid, qb.Err
 }
 
+// RelationCountEqual is called internally
+func (qb *QueryBuilder) RelationCountEqual(relation *RelationToOne, count uint32) (ConditionId, error) {
+	var cid ConditionId
+
+	if qb.Err == nil && qb.checkRelationTarget(relation) {
+		cid = qb.getConditionId(C.obx_qb_relation_count_property(qb.cqb, C.obx_schema_id(relation.Property.Entity.Id),
+			C.obx_schema_id(relation.Property.Id), C.uint32_t(count)))
+	}
+
+	return cid, qb.Err
+}
+
+// RelationCountLess is called internally
+func (qb *QueryBuilder) RelationCountLess(relation *RelationToOne, count uint32, withEqual bool) (ConditionId, error) {
+	if qb.Err != nil || !qb.checkRelationTarget(relation) {
+		return 0, qb.Err
+	}
+
+	if !withEqual && count == 0 {
+		qb.Err = errors.New("relation count can't be less than 0")
+		return 0, qb.Err
+	}
+
+	// exclude objects with more related objects than allowed
+	var threshold = uint64(count)
+	if withEqual {
+		threshold++
+	}
+	return qb.relationCountIds(relation, threshold, false)
+}
+
+// RelationCountGreater is called internally
+func (qb *QueryBuilder) RelationCountGreater(relation *RelationToOne, count uint32, withEqual bool) (ConditionId, error) {
+	if qb.Err != nil || !qb.checkRelationTarget(relation) {
+		return 0, qb.Err
+	}
+
+	var threshold = uint64(count)
+	if !withEqual {
+		threshold++
+	}
+
+	// all objects match; note: IDs are never 0
+	if threshold == 0 {
+		var idProperty = qb.objectBox.getEntityById(qb.typeId).schema.IdProperty()
+		return qb.getConditionId(C.obx_qb_greater_than_int(qb.cqb, C.obx_schema_id(idProperty.Id), 0)), qb.Err
+	}
+
+	return qb.relationCountIds(relation, threshold, true)
+}
+
+// relationCountIds creates an ID condition matching objects (in = true) or excluding them (in = false) if they have
+// at least `threshold` related objects. The core only supports matching an exact count, therefore the actual IDs are
+// determined by the query each time it's executed, see Query.prepareRelationCounts().
+func (qb *QueryBuilder) relationCountIds(relation *RelationToOne, threshold uint64, in bool) (ConditionId, error) {
+	var idProperty = C.obx_schema_id(qb.objectBox.getEntityById(qb.typeId).schema.IdProperty().Id)
+
+	// a placeholder until the parameter is set; IDs are never 0 so it doesn't match any object
+	var placeholder = []int64{0}
+
+	var cid ConditionId
+	if in {
+		cid = qb.getConditionId(C.obx_qb_in_int64s(qb.cqb, idProperty, goInt64ArrayToC(placeholder), 1))
+	} else {
+		cid = qb.getConditionId(C.obx_qb_not_in_int64s(qb.cqb, idProperty, goInt64ArrayToC(placeholder), 1))
+	}
+
+	var param = &relationCountParam{relation: relation, threshold: threshold}
+	param.alias = fmt.Sprintf("objectbox-go/relation-count/%p", param)
+	if qb.Alias(param.alias) != nil {
+		return 0, qb.Err
+	}
+
+	qb.relationCounts = append(qb.relationCounts, param)
+	return cid, qb.Err
+}
+
+// checkRelationTarget verifies the relation points to the entity of this query builder, i.e. it's used as a backlink
+func (qb *QueryBuilder) checkRelationTarget(relation *RelationToOne) bool {
+	if qb.typeId == relation.Target.Id {
+		return true
+	}
+
+	if qb.Err == nil {
+		qb.Err = fmt.Errorf("relation targeting a different entity %d passed, expected %d", relation.Target.Id,
+			qb.typeId)
+	}
+
+	return false
+}
+
 // IsNil is called internally
 func (qb *QueryBuilder) IsNil(property *BaseProperty) (ConditionId, error) {
 	var cid ConditionId
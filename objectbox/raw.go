@@ -0,0 +1,193 @@
+/*
+ * Copyright 2018-2025 ObjectBox Ltd. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package objectbox
+
+/*
+#include "objectbox.h"
+*/
+import "C"
+
+import (
+	"runtime"
+	"unsafe"
+)
+
+// Raw access to the stored FlatBuffers data, e.g. for re-encoding or forwarding objects without decoding them.
+//
+// The zero-copy variants (GetRaw, FindRaw, VisitRaw) return slices referencing database memory directly. Those are only
+// valid inside the transaction they were read in and must not be modified. Therefore, GetRaw() and FindRaw() require
+// a box bound to a transaction (see Tx.Box()); use the copying variants (GetRawCopy, FindRawCopy) otherwise.
+
+// GetRaw returns the data of the object with the given ID without copying it; nil if there's no such object.
+// The box must be bound to a transaction, see Tx.Box(); the result is only valid until the transaction is finished.
+func (box *Box) GetRaw(id uint64) ([]byte, error) {
+	if err := box.checkRawTx(); err != nil {
+		return nil, err
+	}
+	return box.getRaw(id)
+}
+
+// GetRawCopy returns a copy of the data of the object with the given ID; nil if there's no such object.
+func (box *Box) GetRawCopy(id uint64) (bytes []byte, err error) {
+	if err := box.checkTx(false); err != nil {
+		return nil, err
+	}
+
+	err = box.ObjectBox.RunInReadTx(func() error {
+		data, err := box.getRaw(id)
+		bytes = copyBytes(data)
+		return err
+	})
+	return bytes, err
+}
+
+// VisitRaw passes the data of all stored objects to fn without copying it; the data is only valid during the call.
+// Return false from fn to stop early.
+func (box *Box) VisitRaw(fn func(bytes []byte) bool) error {
+	if err := box.checkTx(false); err != nil {
+		return err
+	}
+
+	return box.visitRaw(fn, func(visitorArg unsafe.Pointer) C.obx_err {
+		return C.obx_box_visit_all(box.cBox, dataVisitor, visitorArg)
+	})
+}
+
+// FindRaw returns the data of all objects matching the query without copying it.
+// The query's box must be bound to a transaction, see Tx.Box(); the result is only valid until the transaction is
+// finished.
+func (query *Query) FindRaw() ([][]byte, error) {
+	defer runtime.KeepAlive(query)
+
+	if err := query.check(); err != nil {
+		return nil, err
+	}
+
+	if err := query.box.checkRawTx(); err != nil {
+		return nil, err
+	}
+
+	return query.findRaw()
+}
+
+// FindRawCopy returns copies of the data of all objects matching the query.
+func (query *Query) FindRawCopy() (result [][]byte, err error) {
+	defer runtime.KeepAlive(query)
+
+	if err := query.check(); err != nil {
+		return nil, err
+	}
+
+	err = query.box.ObjectBox.RunInReadTx(func() error {
+		data, err := query.findRaw()
+		if err != nil {
+			return err
+		}
+
+		result = make([][]byte, len(data))
+		for i := range data {
+			result[i] = copyBytes(data[i])
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// VisitRaw passes the data of all objects matching the query to fn without copying it; the data is only valid during
+// the call. Return false from fn to stop early.
+func (query *Query) VisitRaw(fn func(bytes []byte) bool) error {
+	defer runtime.KeepAlive(query)
+
+	if err := query.check(); err != nil {
+		return err
+	}
+
+	return query.box.visitRaw(fn, func(visitorArg unsafe.Pointer) C.obx_err {
+		return C.obx_query_visit(query.cQuery, dataVisitor, visitorArg)
+	})
+}
+
+// checkRawTx verifies the box is bound to a transaction so that the zero-copy data stays valid after returning
+func (box *Box) checkRawTx() error {
+	if box.tx == nil {
+		return newIllegalStateError("zero-copy access requires a box bound to a transaction (see Tx.Box()), " +
+			"use a copying variant otherwise")
+	}
+	return box.checkTx(false)
+}
+
+// getRaw must be called inside a transaction
+func (box *Box) getRaw(id uint64) ([]byte, error) {
+	var dataPtr unsafe.Pointer
+	var dataSize C.size_t
+
+	var rc = C.obx_box_get(box.cBox, C.obx_id(id), &dataPtr, &dataSize)
+	if rc == 0 {
+		var bytes []byte
+		cVoidPtrToByteSlice(dataPtr, int(dataSize), &bytes)
+		return bytes, nil
+	} else if rc == C.OBX_NOT_FOUND {
+		return nil, nil
+	}
+
+	// NOTE: no need for manual runtime.LockOSThread() because we're inside a transaction
+	return nil, createError()
+}
+
+// findRaw must be called inside a transaction
+func (query *Query) findRaw() ([][]byte, error) {
+	if supportsResultArray {
+		// cBytesArrayToGo references the data, which stays valid after freeing the array until the transaction ends
+		return cGetBytesArray(func() *C.OBX_bytes_array {
+			return C.obx_query_find(query.cQuery)
+		})
+	}
+
+	var result [][]byte
+	var err = query.box.visitRaw(func(bytes []byte) bool {
+		result = append(result, bytes)
+		return true
+	}, func(visitorArg unsafe.Pointer) C.obx_err {
+		return C.obx_query_visit(query.cQuery, dataVisitor, visitorArg)
+	})
+	return result, err
+}
+
+// visitRaw calls the native function with a data visitor forwarding to fn, inside a read transaction
+func (box *Box) visitRaw(fn func(bytes []byte) bool, cFn func(visitorArg unsafe.Pointer) C.obx_err) error {
+	visitor, err := dataVisitorRegister(fn)
+	if err != nil {
+		return err
+	}
+	defer dataVisitorUnregister(visitor)
+
+	return box.ObjectBox.RunInReadTx(func() error {
+		return cCall(func() C.obx_err { return cFn(unsafe.Pointer(&visitor)) })
+	})
+}
+
+func copyBytes(data []byte) []byte {
+	if data == nil {
+		return nil
+	}
+	var result = make([]byte, len(data))
+	copy(result, data)
+	return result
+}
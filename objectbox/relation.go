@@ -23,17 +23,29 @@ import (
 type conditionRelationOneToMany struct {
 	relation   *RelationToOne
 	conditions []Condition
+	backlink   bool    // follow the relation in the reverse direction, see RelationToOne.Backlink()
 	alias      *string // this is only used to report an error
 }
 
 func (condition *conditionRelationOneToMany) applyTo(qb *QueryBuilder, isRoot bool) (ConditionId, error) {
 	if condition.alias != nil {
-		return 0, fmt.Errorf("using Alias/As(\"%s\") on a OneToMany relation link is not supported", *condition.alias)
+		return 0, fmt.Errorf("using Alias/As(\"%s\") on a OneToMany relation %s is not supported", *condition.alias,
+			linkKind(condition.backlink))
 	}
 
+	if condition.backlink {
+		return conditionIdFakeLink, qb.BacklinkOneToMany(condition.relation, condition.conditions)
+	}
 	return conditionIdFakeLink, qb.LinkOneToMany(condition.relation, condition.conditions)
 }
 
+func linkKind(backlink bool) string {
+	if backlink {
+		return "backlink"
+	}
+	return "link"
+}
+
 // Alias sets a string alias for the given condition. It can later be used in Query.Set*Params() methods.
 // This is an invalid call on Relation links and will result in an error.
 func (condition *conditionRelationOneToMany) Alias(alias string) Condition {
@@ -73,6 +85,13 @@ func (relation *RelationToOne) Link(conditions ...Condition) Condition {
 	return &conditionRelationOneToMany{relation: relation, conditions: conditions}
 }
 
+// Backlink follows the relation in the reverse direction, i.e. from the target to the source entity, and takes inner
+// conditions to evaluate on the source entity. Use it in a query of the target entity, e.g. to find customers by
+// conditions on the orders pointing to them. Aliases can be set on the inner conditions, same as with Link().
+func (relation *RelationToOne) Backlink(conditions ...Condition) Condition {
+	return &conditionRelationOneToMany{relation: relation, conditions: conditions, backlink: true}
+}
+
 // Equals finds entities with relation target ID equal to the given value
 func (relation RelationToOne) Equals(value uint64) Condition {
 	return &conditionClosure{
@@ -119,17 +138,110 @@ func (relation RelationToOne) NotIn(values ...uint64) Condition {
 	}
 }
 
+// Count creates conditions on the number of source objects pointing to an object using this relation, e.g. to find
+// customers with at least 3 orders: box.Query(Order_.Customer.Count().GreaterOrEqual(3)), with box for Customer.
+// The conditions may be combined with others using All() and Any().
+func (relation *RelationToOne) Count() *RelationCount {
+	return &RelationCount{relation: relation}
+}
+
+// RelationCount creates conditions on the number of related (source) objects, see RelationToOne.Count().
+// Note: the core only matches an exact count, therefore LessThan(), LessOrEqual(), GreaterThan() and GreaterOrEqual()
+// count the related objects each time the query is executed and match the resulting object IDs. These conditions
+// don't support Alias().
+// Performance: each execution of such a query reads the relation of all source objects, i.e. the cost grows with the
+// number of source objects (not just with the number of results). The counting and the query itself run in the same
+// read transaction so the results are consistent.
+type RelationCount struct {
+	relation *RelationToOne
+}
+
+// Equals finds objects with exactly the given number of related objects; use 0 to find objects without any
+func (rc *RelationCount) Equals(count uint32) Condition {
+	return &conditionClosure{
+		apply: func(qb *QueryBuilder) (ConditionId, error) {
+			return qb.RelationCountEqual(rc.relation, count)
+		},
+	}
+}
+
+// LessThan finds objects with fewer than the given number of related objects
+func (rc *RelationCount) LessThan(count uint32) Condition {
+	return &conditionRelationCount{
+		apply: func(qb *QueryBuilder) (ConditionId, error) {
+			return qb.RelationCountLess(rc.relation, count, false)
+		},
+	}
+}
+
+// LessOrEqual finds objects with the given or a lower number of related objects
+func (rc *RelationCount) LessOrEqual(count uint32) Condition {
+	return &conditionRelationCount{
+		apply: func(qb *QueryBuilder) (ConditionId, error) {
+			return qb.RelationCountLess(rc.relation, count, true)
+		},
+	}
+}
+
+// GreaterThan finds objects with more than the given number of related objects
+func (rc *RelationCount) GreaterThan(count uint32) Condition {
+	return &conditionRelationCount{
+		apply: func(qb *QueryBuilder) (ConditionId, error) {
+			return qb.RelationCountGreater(rc.relation, count, false)
+		},
+	}
+}
+
+// GreaterOrEqual finds objects with the given or a higher number of related objects
+func (rc *RelationCount) GreaterOrEqual(count uint32) Condition {
+	return &conditionRelationCount{
+		apply: func(qb *QueryBuilder) (ConditionId, error) {
+			return qb.RelationCountGreater(rc.relation, count, true)
+		},
+	}
+}
+
+// conditionRelationCount is like conditionClosure but the condition already uses an alias internally
+type conditionRelationCount struct {
+	apply func(qb *QueryBuilder) (ConditionId, error)
+	alias *string // this is only used to report an error
+}
+
+func (condition *conditionRelationCount) applyTo(qb *QueryBuilder, isRoot bool) (ConditionId, error) {
+	if condition.alias != nil {
+		return 0, fmt.Errorf("using Alias/As(\"%s\") on a relation count condition is not supported", *condition.alias)
+	}
+	return condition.apply(qb)
+}
+
+// Alias sets a string alias for the given condition. It can later be used in Query.Set*Params() methods
+func (condition *conditionRelationCount) Alias(alias string) Condition {
+	condition.alias = &alias
+	return condition
+}
+
+// As sets an alias for the given condition. It can later be used in Query.Set*Params() methods.
+func (condition *conditionRelationCount) As(alias *alias) Condition {
+	condition.alias = alias.alias()
+	return condition
+}
+
 type conditionRelationManyToMany struct {
 	relation   *RelationToMany
 	conditions []Condition
+	backlink   bool    // follow the relation in the reverse direction, see RelationToMany.Backlink()
 	alias      *string // this is only used to report an error
 }
 
 func (condition *conditionRelationManyToMany) applyTo(qb *QueryBuilder, isRoot bool) (ConditionId, error) {
 	if condition.alias != nil {
-		return 0, fmt.Errorf("using Alias/As(\"%s\") on a ManyToMany relation link is not supported", *condition.alias)
+		return 0, fmt.Errorf("using Alias/As(\"%s\") on a ManyToMany relation %s is not supported", *condition.alias,
+			linkKind(condition.backlink))
 	}
 
+	if condition.backlink {
+		return conditionIdFakeLink, qb.BacklinkManyToMany(condition.relation, condition.conditions)
+	}
 	return conditionIdFakeLink, qb.LinkManyToMany(condition.relation, condition.conditions)
 }
 
@@ -161,4 +273,53 @@ func (relation *RelationToMany) Link(conditions ...Condition) Condition {
 	return &conditionRelationManyToMany{relation: relation, conditions: conditions}
 }
 
+// Backlink follows the relation in the reverse direction, i.e. from the target to the source entity, and takes inner
+// conditions to evaluate on the source entity. Use it in a query of the target entity. Aliases can be set on the
+// inner conditions, same as with Link().
+func (relation *RelationToMany) Backlink(conditions ...Condition) Condition {
+	return &conditionRelationManyToMany{relation: relation, conditions: conditions, backlink: true}
+}
+
 // TODO contains() would make sense for many-to-many (slice)
+
+// relationBacklinkCounts returns the number of source objects pointing to each target object ID using the relation
+func (ob *ObjectBox) relationBacklinkCounts(relation *RelationToOne) (map[uint64]uint32, error) {
+	box, err := ob.box(relation.Property.Entity.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	query, err := box.QueryOrError()
+	if err != nil {
+		return nil, err
+	}
+	defer query.Close()
+
+	pq, err := query.PropertyOrError(relation)
+	if err != nil {
+		return nil, err
+	}
+
+	// nil values (no relation) are skipped
+	targetIds, err := pq.FindUint64s(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var counts = make(map[uint64]uint32)
+	for _, id := range targetIds {
+		if id != 0 {
+			counts[id]++
+		}
+	}
+	return counts, nil
+}
+
+// relationCountParam is a relation count condition parameter, updated before each query execution
+type relationCountParam struct {
+	relation *RelationToOne
+	alias    string
+
+	// IDs of objects with at least this many related objects are the parameter value
+	threshold uint64
+}
@@ -0,0 +1,189 @@
+/*
+ * Copyright 2018-2025 ObjectBox Ltd. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package objectbox
+
+/*
+#include "objectbox.h"
+*/
+import "C"
+
+// PropertyType is the storage type of a property, as passed to Model.Property()
+type PropertyType int
+
+// Property types, matching OBXPropertyType of the ObjectBox C API
+const (
+	PropertyTypeBool           PropertyType = C.OBXPropertyType_Bool
+	PropertyTypeByte           PropertyType = C.OBXPropertyType_Byte
+	PropertyTypeShort          PropertyType = C.OBXPropertyType_Short
+	PropertyTypeChar           PropertyType = C.OBXPropertyType_Char
+	PropertyTypeInt            PropertyType = C.OBXPropertyType_Int
+	PropertyTypeLong           PropertyType = C.OBXPropertyType_Long
+	PropertyTypeFloat          PropertyType = C.OBXPropertyType_Float
+	PropertyTypeDouble         PropertyType = C.OBXPropertyType_Double
+	PropertyTypeString         PropertyType = C.OBXPropertyType_String
+	PropertyTypeDate           PropertyType = C.OBXPropertyType_Date
+	PropertyTypeRelation       PropertyType = C.OBXPropertyType_Relation
+	PropertyTypeDateNano       PropertyType = C.OBXPropertyType_DateNano
+	PropertyTypeFlex           PropertyType = C.OBXPropertyType_Flex
+	PropertyTypeBoolVector     PropertyType = C.OBXPropertyType_BoolVector
+	PropertyTypeByteVector     PropertyType = C.OBXPropertyType_ByteVector
+	PropertyTypeShortVector    PropertyType = C.OBXPropertyType_ShortVector
+	PropertyTypeCharVector     PropertyType = C.OBXPropertyType_CharVector
+	PropertyTypeIntVector      PropertyType = C.OBXPropertyType_IntVector
+	PropertyTypeLongVector     PropertyType = C.OBXPropertyType_LongVector
+	PropertyTypeFloatVector    PropertyType = C.OBXPropertyType_FloatVector
+	PropertyTypeDoubleVector   PropertyType = C.OBXPropertyType_DoubleVector
+	PropertyTypeStringVector   PropertyType = C.OBXPropertyType_StringVector
+	PropertyTypeDateVector     PropertyType = C.OBXPropertyType_DateVector
+	PropertyTypeDateNanoVector PropertyType = C.OBXPropertyType_DateNanoVector
+)
+
+// Property flags, matching OBXPropertyFlags of the ObjectBox C API; see SchemaProperty.Flags
+const (
+	PropertyFlagId                      = C.OBXPropertyFlags_ID
+	PropertyFlagNonPrimitiveType        = C.OBXPropertyFlags_NON_PRIMITIVE_TYPE
+	PropertyFlagNotNull                 = C.OBXPropertyFlags_NOT_NULL
+	PropertyFlagIndexed                 = C.OBXPropertyFlags_INDEXED
+	PropertyFlagUnique                  = C.OBXPropertyFlags_UNIQUE
+	PropertyFlagIdMonotonicSequence     = C.OBXPropertyFlags_ID_MONOTONIC_SEQUENCE
+	PropertyFlagIdSelfAssignable        = C.OBXPropertyFlags_ID_SELF_ASSIGNABLE
+	PropertyFlagIndexPartialSkipNull    = C.OBXPropertyFlags_INDEX_PARTIAL_SKIP_NULL
+	PropertyFlagIndexPartialSkipZero    = C.OBXPropertyFlags_INDEX_PARTIAL_SKIP_ZERO
+	PropertyFlagVirtual                 = C.OBXPropertyFlags_VIRTUAL
+	PropertyFlagIndexHash               = C.OBXPropertyFlags_INDEX_HASH
+	PropertyFlagIndexHash64             = C.OBXPropertyFlags_INDEX_HASH64
+	PropertyFlagUnsigned                = C.OBXPropertyFlags_UNSIGNED
+	PropertyFlagIdCompanion             = C.OBXPropertyFlags_ID_COMPANION
+	PropertyFlagUniqueOnConflictReplace = C.OBXPropertyFlags_UNIQUE_ON_CONFLICT_REPLACE
+	PropertyFlagExpirationTime          = C.OBXPropertyFlags_EXPIRATION_TIME
+)
+
+// Entity flags, matching OBXEntityFlags of the ObjectBox C API; see SchemaEntity.Flags
+const (
+	EntityFlagSyncEnabled     = C.OBXEntityFlags_SYNC_ENABLED
+	EntityFlagSharedGlobalIds = C.OBXEntityFlags_SHARED_GLOBAL_IDS
+)
+
+// Schema is a read-only description of the data model a store was opened with, see ObjectBox.Schema().
+// Don't modify its contents - it is shared by all callers.
+type Schema struct {
+	// Entities in the order they were registered
+	Entities []*SchemaEntity
+
+	LastEntityId    TypeId
+	LastEntityUid   uint64
+	LastIndexId     TypeId
+	LastIndexUid    uint64
+	LastRelationId  TypeId
+	LastRelationUid uint64
+}
+
+// SchemaEntity describes an entity (i.e. a "table") in the Schema
+type SchemaEntity struct {
+	Id    TypeId
+	Uid   uint64
+	Name  string
+	Flags int // a combination of EntityFlag* constants
+
+	// Properties in the order they were declared
+	Properties []*SchemaProperty
+
+	// Relations are the "standalone" (many-to-many) relations with this entity as a source
+	Relations []*SchemaRelation
+
+	LastPropertyId  TypeId
+	LastPropertyUid uint64
+}
+
+// SchemaProperty describes a single property of an entity
+type SchemaProperty struct {
+	Id    TypeId
+	Uid   uint64
+	Name  string
+	Type  PropertyType
+	Flags int // a combination of PropertyFlag* constants
+
+	// IndexId and IndexUid are non-zero if the property is indexed (this includes to-one relations)
+	IndexId  TypeId
+	IndexUid uint64
+
+	// RelationTarget is the name of the target entity for a to-one relation property, empty otherwise
+	RelationTarget string
+}
+
+// SchemaRelation describes a "standalone" many-to-many relation
+type SchemaRelation struct {
+	Id              TypeId
+	Uid             uint64
+	TargetEntityId  TypeId
+	TargetEntityUid uint64
+}
+
+// Entity returns the entity with the given name or nil if there's no such entity
+func (schema *Schema) Entity(name string) *SchemaEntity {
+	for _, entity := range schema.Entities {
+		if entity.Name == name {
+			return entity
+		}
+	}
+	return nil
+}
+
+// EntityById returns the entity with the given ID or nil if there's no such entity
+func (schema *Schema) EntityById(id TypeId) *SchemaEntity {
+	for _, entity := range schema.Entities {
+		if entity.Id == id {
+			return entity
+		}
+	}
+	return nil
+}
+
+// Property returns the property with the given name or nil if there's no such property
+func (entity *SchemaEntity) Property(name string) *SchemaProperty {
+	for _, property := range entity.Properties {
+		if property.Name == name {
+			return property
+		}
+	}
+	return nil
+}
+
+// PropertyById returns the property with the given ID or nil if there's no such property
+func (entity *SchemaEntity) PropertyById(id TypeId) *SchemaProperty {
+	for _, property := range entity.Properties {
+		if property.Id == id {
+			return property
+		}
+	}
+	return nil
+}
+
+// IdProperty returns the ID property of the entity, i.e. the one with PropertyFlagId set
+func (entity *SchemaEntity) IdProperty() *SchemaProperty {
+	for _, property := range entity.Properties {
+		if property.Flags&PropertyFlagId != 0 {
+			return property
+		}
+	}
+	return nil
+}
+
+// HasFlag checks whether all of the given property flags are set
+func (property *SchemaProperty) HasFlag(flags int) bool {
+	return property.Flags&flags == flags
+}
@@ -23,6 +23,7 @@ package objectbox
 import "C"
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"time"
@@ -273,6 +274,48 @@ func (client *SyncClient) WaitForLogin(timeout time.Duration) (successful bool,
 		return false, fmt.Errorf("timeout must be >= 1 millisecond, %d given", timeoutMs)
 	}
 
+	return client.waitForLoggedInState(timeoutMs)
+}
+
+// WaitForLoginContext is like WaitForLogin() but waits until the context is done instead of a fixed timeout.
+// Returns:
+//
+//	(true, nil) in case the login was successful;
+//	(false, ctx.Err()) in case the context was cancelled or its deadline exceeded;
+//	(false, error) if an error occurred (such as wrong credentials)
+func (client *SyncClient) WaitForLoginContext(ctx context.Context) (successful bool, err error) {
+	if !client.started {
+		if err := client.Start(); err != nil {
+			return false, err
+		}
+	}
+
+	// wait in short intervals so that a cancellation is noticed without a significant delay
+	const intervalMs = 100
+	for {
+		if err := ctx.Err(); err != nil {
+			return false, err
+		}
+
+		var timeoutMs int64 = intervalMs
+		if deadline, ok := ctx.Deadline(); ok {
+			if remainingMs := time.Until(deadline).Nanoseconds() / 1000 / 1000; remainingMs < timeoutMs {
+				timeoutMs = remainingMs
+			}
+			if timeoutMs < 1 {
+				timeoutMs = 1
+			}
+		}
+
+		successful, err := client.waitForLoggedInState(timeoutMs)
+		if successful || err != nil {
+			return successful, err
+		}
+	}
+}
+
+// waitForLoggedInState returns (false, nil) on timeout, see WaitForLogin()
+func (client *SyncClient) waitForLoggedInState(timeoutMs int64) (bool, error) {
 	var code = C.obx_sync_wait_for_logged_in_state(client.cClient, C.uint64_t(timeoutMs))
 	switch code {
 	case C.OBX_SUCCESS:
@@ -0,0 +1,254 @@
+/*
+ * Copyright 2018-2025 ObjectBox Ltd. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package objectbox
+
+/*
+#include <stdint.h>
+#include "objectbox.h"
+
+#ifdef _WIN32
+#include <windows.h>
+static uint64_t currentThreadId() { return (uint64_t) GetCurrentThreadId(); }
+#else
+#include <pthread.h>
+static uint64_t currentThreadId() { return (uint64_t) (uintptr_t) pthread_self(); }
+#endif
+*/
+import "C"
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+)
+
+// Tx is an explicitly managed transaction, an alternative to ObjectBox.RunInReadTx() and ObjectBox.RunInWriteTx()
+// for code that can't be structured as a single callback, e.g. a request handler spanning multiple layers.
+//
+// Native transactions are bound to the OS thread they were created on. Therefore, BeginReadTx() and BeginWriteTx()
+// lock the calling goroutine to its current OS thread until the transaction is finished using Commit(), Abort() or
+// Close(). The transaction, and boxes bound to it using Tx.Box(), may only be used from the same goroutine;
+// otherwise an error is returned. Always make sure the transaction is finished, e.g.:
+//
+//	tx, err := ob.BeginWriteTx()
+//	if err != nil {
+//		return err
+//	}
+//	defer tx.Close() // rolls back in case Commit() isn't reached
+//
+//	var box = tx.Box(BoxForPerson(ob).Box)
+//	...
+//	return tx.Commit()
+type Tx struct {
+	objectBox *ObjectBox
+	cTxn      *C.OBX_txn
+	readOnly  bool
+	threadId  C.uint64_t
+}
+
+var errTxFinished = newIllegalStateError("the transaction has already been finished")
+var errTxOtherGoroutine = newIllegalStateError("the transaction is used from another goroutine than the one " +
+	"which started it - transactions are bound to the goroutine (OS thread) that created them")
+var errTxReadOnly = newIllegalStateError("cannot write in a read transaction")
+
+// BeginReadTx starts a read transaction, see Tx for details.
+func (ob *ObjectBox) BeginReadTx() (*Tx, error) {
+	return ob.beginTx(true)
+}
+
+// BeginWriteTx starts a write transaction, see Tx for details.
+// The changes are only persisted once Commit() is called.
+func (ob *ObjectBox) BeginWriteTx() (*Tx, error) {
+	if err := ob.checkWritable(); err != nil {
+		return nil, err
+	}
+	return ob.beginTx(false)
+}
+
+func (ob *ObjectBox) beginTx(readOnly bool) (*Tx, error) {
+	// unlocked when the transaction is finished
+	runtime.LockOSThread()
+
+	var tx = &Tx{
+		objectBox: ob,
+		readOnly:  readOnly,
+		threadId:  C.currentThreadId(),
+	}
+
+	if readOnly {
+		tx.cTxn = C.obx_txn_read(ob.store)
+	} else {
+		tx.cTxn = C.obx_txn_write(ob.store)
+	}
+
+	if tx.cTxn == nil {
+		var err = createError()
+		runtime.UnlockOSThread()
+		return nil, err
+	}
+
+	ob.txStarted()
+	return tx, nil
+}
+
+// IsReadOnly returns true for read transactions, i.e. those started by BeginReadTx()
+func (tx *Tx) IsReadOnly() bool {
+	return tx.readOnly
+}
+
+// IsActive returns true until the transaction is finished by Commit(), Abort() or Close()
+func (tx *Tx) IsActive() bool {
+	return tx.cTxn != nil
+}
+
+// Commit persists the changes of a write transaction and finishes it; for a read transaction, it's the same as Close().
+func (tx *Tx) Commit() error {
+	if err := tx.check(); err != nil {
+		return err
+	}
+
+	return tx.finish(!tx.readOnly)
+}
+
+// CommitContext is like Commit() but if the context is done, the transaction is rolled back (see Abort()) instead and
+// the context's error is returned.
+func (tx *Tx) CommitContext(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		if err2 := tx.Abort(); err2 != nil {
+			return fmt.Errorf("%s; %s", err, err2)
+		}
+		return err
+	}
+	return tx.Commit()
+}
+
+// Abort rolls back all changes of the transaction and finishes it.
+func (tx *Tx) Abort() error {
+	if err := tx.check(); err != nil {
+		return err
+	}
+
+	var rc = C.obx_txn_abort(tx.cTxn)
+	var err error
+	if rc != 0 {
+		err = createError()
+	}
+
+	if err2 := tx.finish(false); err == nil {
+		err = err2
+	}
+	return err
+}
+
+// Close finishes the transaction, rolling back any uncommitted changes.
+// It's safe to call Close() on an already finished transaction, so it's convenient to use with defer.
+func (tx *Tx) Close() error {
+	if tx.cTxn == nil {
+		return nil
+	}
+
+	if err := tx.check(); err != nil {
+		return err
+	}
+
+	return tx.finish(false)
+}
+
+// finish commits (obx_txn_success) or closes the native transaction and unlocks the OS thread
+func (tx *Tx) finish(commit bool) error {
+	var cTxn = tx.cTxn
+	tx.cTxn = nil
+
+	var rc C.obx_err
+	if commit {
+		rc = C.obx_txn_success(cTxn)
+	} else {
+		rc = C.obx_txn_close(cTxn)
+	}
+
+	var err error
+	if rc != 0 {
+		err = createError()
+	}
+
+	tx.objectBox.txFinished(uint64(tx.threadId))
+	runtime.UnlockOSThread()
+	return err
+}
+
+// txStarted records a transaction started on the current OS thread, returning the thread's ID.
+// The calling goroutine must be locked to the thread until the transaction is finished, see txFinished().
+func (ob *ObjectBox) txStarted() uint64 {
+	var threadId = uint64(C.currentThreadId())
+
+	ob.txThreadsMutex.Lock()
+	defer ob.txThreadsMutex.Unlock()
+
+	if ob.txThreads == nil {
+		ob.txThreads = make(map[uint64]int)
+	}
+	ob.txThreads[threadId]++
+	return threadId
+}
+
+// txFinished removes a transaction recorded by txStarted()
+func (ob *ObjectBox) txFinished(threadId uint64) {
+	ob.txThreadsMutex.Lock()
+	defer ob.txThreadsMutex.Unlock()
+
+	if ob.txThreads[threadId] > 1 {
+		ob.txThreads[threadId]--
+	} else {
+		delete(ob.txThreads, threadId)
+	}
+}
+
+// inTx returns true if a transaction is active on the OS thread of the calling goroutine, i.e. the caller runs inside
+// RunInReadTx(), RunInWriteTx() or an unfinished Tx. Transactions lock their goroutine to the thread so no other
+// goroutine can be running on it in the meantime.
+func (ob *ObjectBox) inTx() bool {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	ob.txThreadsMutex.Lock()
+	defer ob.txThreadsMutex.Unlock()
+
+	return ob.txThreads[uint64(C.currentThreadId())] > 0
+}
+
+func (tx *Tx) check() error {
+	if tx.cTxn == nil {
+		return errTxFinished
+	}
+	if tx.threadId != C.currentThreadId() {
+		return errTxOtherGoroutine
+	}
+	return nil
+}
+
+// Box returns a copy of the given box bound to this transaction. Operations on the returned box, as well as on queries
+// created by it, verify they're executed inside this transaction, from the goroutine which started it.
+// To get a generated typed box, wrap the result, e.g. &PersonBox{Box: tx.Box(BoxForPerson(ob).Box)}.
+func (tx *Tx) Box(box *Box) *Box {
+	if box.ObjectBox != tx.objectBox {
+		panic(fmt.Sprintf("Configuration error; box for entity %s belongs to a different store", box.entity.name))
+	}
+
+	var bound = *box
+	bound.tx = tx
+	return &bound
+}
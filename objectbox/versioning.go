@@ -0,0 +1,200 @@
+/*
+ * Copyright 2018-2025 ObjectBox Ltd. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package objectbox
+
+import (
+	"fmt"
+	"reflect"
+
+	flatbuffers "github.com/google/flatbuffers/go"
+)
+
+// VersionProperty enables optimistic concurrency control for the given entity, using the given integer property as the
+// object version. Box.Put(), Update() and others then check, inside the write transaction, that the version of the
+// object being written matches the stored one (a missing object counts as version 0) and increment it - both in the
+// database and on the given object. If the versions differ, a *VersionConflictError is returned instead.
+// Box.UpdateProperties() and Query.Update() increment the stored version, unless it's set explicitly.
+//
+// Call it on the model before passing it to Builder.Model(), e.g.:
+//
+//	var model = ObjectBoxModel()
+//	model.VersionProperty("Document", "Version")
+//	ob, err := objectbox.NewBuilder().Model(model).Build()
+//
+// Note: if an outer transaction (e.g. ObjectBox.RunInWriteTx()) is rolled back after a successful put, the incremented
+// version stays on the object.
+func (model *Model) VersionProperty(entityName, propertyName string) {
+	if model.Error != nil {
+		return
+	}
+
+	var entity = model.entitiesByName[entityName]
+	if entity == nil {
+		model.Error = fmt.Errorf("can't set version property: entity %s not found", entityName)
+		return
+	}
+
+	var property = entity.schema.Property(propertyName)
+	if property == nil {
+		model.Error = fmt.Errorf("can't set version property: property %s.%s not found", entityName, propertyName)
+		return
+	}
+
+	if property.HasFlag(PropertyFlagId) {
+		model.Error = fmt.Errorf("can't set version property: %s.%s is the ID property", entityName, propertyName)
+		return
+	}
+
+	if property.Type != PropertyTypeInt && property.Type != PropertyTypeLong {
+		model.Error = fmt.Errorf("can't set version property: %s.%s must be an integer (int32/int64) property, "+
+			"has type %d", entityName, propertyName, property.Type)
+		return
+	}
+
+	entity.versionProperty = property
+}
+
+// checkVersion verifies the version of the given object matches the stored one and increments it on the object.
+// Must be called inside a write transaction, before the object is flattened. The returned function reverts the object
+// version and must be called if the object couldn't be stored.
+func (box *Box) checkVersion(object interface{}, id uint64) (restore func(), err error) {
+	var property = box.entity.versionProperty
+
+	version, err := objectVersion(object, property)
+	if err != nil {
+		return nil, err
+	}
+
+	storedVersion, err := box.storedVersion(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if version != storedVersion {
+		return nil, &VersionConflictError{
+			Entity:        box.entity.name,
+			Id:            id,
+			Version:       version,
+			StoredVersion: storedVersion,
+		}
+	}
+
+	if err := setObjectVersion(object, property, version+1); err != nil {
+		return nil, err
+	}
+
+	return func() { _ = setObjectVersion(object, property, version) }, nil
+}
+
+// restoreVersions reverts version changes of objects that couldn't be stored, see checkVersion()
+func restoreVersions(restores []func()) {
+	for _, restore := range restores {
+		restore()
+	}
+}
+
+// storedVersion reads the version of the stored object; 0 if it doesn't exist (or is new, i.e. id is 0).
+// Must be called inside a transaction.
+func (box *Box) storedVersion(id uint64) (int64, error) {
+	if id == 0 {
+		return 0, nil
+	}
+
+	data, err := box.getRaw(id)
+	if err != nil || data == nil {
+		return 0, err
+	}
+
+	var table = &flatbuffers.Table{
+		Bytes: data,
+		Pos:   flatbuffers.GetUOffsetT(data),
+	}
+
+	var reader = &dynamicBinding{schema: box.entity.schema}
+	var value = reader.getSlot(table, box.entity.versionProperty)
+	if value == nil {
+		return 0, nil
+	}
+	return dynamicInt64(value, 64)
+}
+
+// objectVersion reads the version property of the object, either a map (DynamicBox) or a pointer to a struct
+func objectVersion(object interface{}, property *SchemaProperty) (int64, error) {
+	if values, ok := object.(map[string]interface{}); ok {
+		var value, exists = values[property.Name]
+		if !exists || value == nil {
+			return 0, nil
+		}
+		return dynamicInt64(value, 64)
+	}
+
+	field, err := versionField(object, property)
+	if err != nil {
+		return 0, err
+	}
+
+	switch field.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return field.Int(), nil
+	default:
+		return int64(field.Uint()), nil
+	}
+}
+
+// setObjectVersion updates the version property of the object, see objectVersion()
+func setObjectVersion(object interface{}, property *SchemaProperty, version int64) error {
+	if values, ok := object.(map[string]interface{}); ok {
+		values[property.Name] = version
+		return nil
+	}
+
+	field, err := versionField(object, property)
+	if err != nil {
+		return err
+	}
+
+	switch field.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		field.SetInt(version)
+	default:
+		field.SetUint(uint64(version))
+	}
+	return nil
+}
+
+// versionField finds the struct field holding the version property, matching its name
+func versionField(object interface{}, property *SchemaProperty) (reflect.Value, error) {
+	var value = reflect.ValueOf(object)
+	if value.Kind() != reflect.Ptr || value.Elem().Kind() != reflect.Struct {
+		return reflect.Value{}, fmt.Errorf("version property %s: expected a pointer to a struct, got %T",
+			property.Name, object)
+	}
+
+	var field = value.Elem().FieldByName(property.Name)
+	if !field.IsValid() {
+		return reflect.Value{}, fmt.Errorf("version property %s: no such field in %T", property.Name, object)
+	}
+
+	switch field.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return field, nil
+	default:
+		return reflect.Value{}, fmt.Errorf("version property %s: field in %T must be an integer, has type %s",
+			property.Name, object, field.Type())
+	}
+}
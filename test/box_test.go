@@ -17,6 +17,7 @@
 package objectbox_test
 
 import (
+	"github.com/objectbox/objectbox-go/objectbox"
 	"github.com/objectbox/objectbox-go/test/assert"
 	"github.com/objectbox/objectbox-go/test/model"
 	"github.com/objectbox/objectbox-go/test/model/iot"
@@ -255,6 +256,76 @@ func TestBoxUpdate(t *testing.T) {
 	assert.Eq(t, object, objectRead)
 }
 
+func TestBoxInsertMany(t *testing.T) {
+	var env = model.NewTestEnv(t)
+	defer env.Close()
+
+	id, err := env.Box.Put(model.Entity47())
+	assert.NoErr(t, err)
+	assert.Eq(t, uint64(1), id)
+
+	var existing = model.Entity47()
+	existing.Id = 1
+	var explicit = model.Entity47()
+	explicit.Id = 10
+	var objects = []*model.Entity{model.Entity47(), existing, explicit, model.Entity47()}
+
+	ids, err := env.Box.InsertMany(objects)
+	assert.Err(t, err)
+	assert.Eq(t, 4, len(ids))
+	assert.True(t, ids[0] > 1 && ids[3] > 1 && ids[0] != ids[3])
+	assert.Eq(t, uint64(0), ids[1])
+	assert.Eq(t, uint64(10), ids[2])
+	assert.Eq(t, ids[0], objects[0].Id)
+
+	putManyErr, ok := err.(*objectbox.PutManyError)
+	assert.True(t, ok)
+	assert.Eq(t, 1, len(putManyErr.Failures))
+	assertErrorIs(t, putManyErr.Failures[1], objectbox.ErrIdAlreadyExists)
+
+	count, err := env.Box.Count()
+	assert.NoErr(t, err)
+	assert.Eq(t, uint64(4), count)
+
+	// no failures
+	ids, err = env.Box.InsertMany([]*model.Entity{model.Entity47()})
+	assert.NoErr(t, err)
+	assert.True(t, len(ids) == 1 && ids[0] != 0)
+}
+
+func TestBoxUpdateMany(t *testing.T) {
+	var env = model.NewTestEnv(t)
+	defer env.Close()
+	env.Populate(3)
+
+	objects, err := env.Box.GetAll()
+	assert.NoErr(t, err)
+	for _, object := range objects {
+		object.String = "updated"
+	}
+
+	var missing = model.Entity47()
+	missing.Id = 100
+	objects = append(objects, missing, model.Entity47())
+
+	err = env.Box.UpdateMany(objects)
+	putManyErr, ok := err.(*objectbox.PutManyError)
+	assert.True(t, ok)
+	assert.Eq(t, 2, len(putManyErr.Failures))
+	assertErrorIs(t, putManyErr.Failures[3], objectbox.ErrNotFound)
+	assertErrorIs(t, putManyErr.Failures[4], objectbox.ErrIllegalArgument)
+
+	count, err := env.Box.Query(model.Entity_.String.Equals("updated", true)).Count()
+	assert.NoErr(t, err)
+	assert.Eq(t, uint64(3), count)
+
+	count, err = env.Box.Count()
+	assert.NoErr(t, err)
+	assert.Eq(t, uint64(3), count)
+
+	assert.NoErr(t, env.Box.UpdateMany(objects[:3]))
+}
+
 func TestBoxCount(t *testing.T) {
 	var env = model.NewTestEnv(t)
 	defer env.Close()
@@ -0,0 +1,343 @@
+/*
+ * Copyright 2018-2025 ObjectBox Ltd. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package objectbox_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/objectbox/objectbox-go/objectbox"
+	"github.com/objectbox/objectbox-go/test/assert"
+	"github.com/objectbox/objectbox-go/test/model"
+)
+
+// prepareDbDir creates a database in a new temporary directory, inserting the given number of objects
+func prepareDbDir(t *testing.T, count int) string {
+	dir, err := ioutil.TempDir("", "objectbox-test")
+	assert.NoErr(t, err)
+
+	ob, err := objectbox.NewBuilder().Directory(dir).Model(model.ObjectBoxModel()).BuildOrError()
+	assert.NoErr(t, err)
+	defer ob.Close()
+
+	var box = model.BoxForEntity(ob)
+	for i := 0; i < count; i++ {
+		_, err = box.Put(model.Entity47())
+		assert.NoErr(t, err)
+	}
+	return dir
+}
+
+func TestBuilderReadOnly(t *testing.T) {
+	var dir = prepareDbDir(t, 2)
+	defer os.RemoveAll(dir)
+
+	ob, err := objectbox.NewBuilder().Directory(dir).Model(model.ObjectBoxModel()).ReadOnly().BuildOrError()
+	assert.NoErr(t, err)
+	defer ob.Close()
+	assert.True(t, ob.IsReadOnly())
+
+	var box = model.BoxForEntity(ob)
+
+	// reading works as usual
+	count, err := box.Count()
+	assert.NoErr(t, err)
+	assert.Eq(t, uint64(2), count)
+
+	object, err := box.Get(1)
+	assert.NoErr(t, err)
+	assert.True(t, object != nil)
+
+	// writes fail fast
+	_, err = box.Put(model.Entity47())
+	assert.Eq(t, objectbox.ErrReadOnly, err)
+
+	_, err = box.Insert(model.Entity47())
+	assert.Eq(t, objectbox.ErrReadOnly, err)
+
+	assert.Eq(t, objectbox.ErrReadOnly, box.Update(object))
+
+	_, err = box.PutMany([]*model.Entity{model.Entity47()})
+	assert.Eq(t, objectbox.ErrReadOnly, err)
+
+	assert.Eq(t, objectbox.ErrReadOnly, box.Remove(object))
+	assert.Eq(t, objectbox.ErrReadOnly, box.RemoveAll())
+
+	_, err = box.RemoveIds(1, 2)
+	assert.Eq(t, objectbox.ErrReadOnly, err)
+
+	_, err = box.Query().Remove()
+	assert.Eq(t, objectbox.ErrReadOnly, err)
+
+	_, err = box.Async().Put(model.Entity47())
+	assert.Eq(t, objectbox.ErrReadOnly, err)
+
+	assert.Eq(t, objectbox.ErrReadOnly, box.Async().RemoveId(1))
+
+	_, err = objectbox.NewAsyncBox(ob, model.EntityBinding.Id, 100)
+	assert.Eq(t, objectbox.ErrReadOnly, err)
+
+	var called = false
+	assert.Eq(t, objectbox.ErrReadOnly, ob.RunInWriteTx(func() error {
+		called = true
+		return nil
+	}))
+	assert.True(t, !called)
+
+	count, err = box.Count()
+	assert.NoErr(t, err)
+	assert.Eq(t, uint64(2), count)
+}
+
+func TestBuilderWal(t *testing.T) {
+	var builder = objectbox.NewBuilder().Directory("memory:wal-test").Model(model.ObjectBoxModel()).
+		Wal(objectbox.WalFlagsEnable).WalMaxFileSizeInKb(1024).WalMaxFileSizeOnOpenInKb(512)
+
+	ob, err := builder.BuildOrError()
+	if !objectbox.WalIsAvailable() {
+		assert.Err(t, err)
+		assert.MustMatch(t, regexp.MustCompile("WAL.*not available"), err.Error())
+		return
+	}
+	assert.NoErr(t, err)
+	defer ob.Close()
+
+	var box = model.BoxForEntity(ob)
+	id, err := box.Put(model.Entity47())
+	assert.NoErr(t, err)
+	assert.Eq(t, uint64(1), id)
+}
+
+func TestBuilderValidateOnOpen(t *testing.T) {
+	var dir = prepareDbDir(t, 10)
+	defer os.RemoveAll(dir)
+
+	ob, err := objectbox.NewBuilder().Directory(dir).Model(model.ObjectBoxModel()).
+		ValidateOnOpenPages(20, objectbox.ValidateOnOpenPagesFlagsVisitLeafPages).
+		ValidateOnOpenKv(objectbox.ValidateOnOpenKvFlagsNone).BuildOrError()
+	assert.NoErr(t, err)
+	defer ob.Close()
+
+	count, err := model.BoxForEntity(ob).Count()
+	assert.NoErr(t, err)
+	assert.Eq(t, uint64(10), count)
+}
+
+func TestCorruptionError(t *testing.T) {
+	var dir = prepareDbDir(t, 1000)
+	defer os.RemoveAll(dir)
+
+	// overwrite everything after the meta pages at the beginning of the file
+	var file = filepath.Join(dir, "data.mdb")
+	data, err := ioutil.ReadFile(file)
+	assert.NoErr(t, err)
+	assert.True(t, len(data) > 2*4096)
+	for i := 2 * 4096; i < len(data); i++ {
+		data[i] = 0xAB
+	}
+	assert.NoErr(t, ioutil.WriteFile(file, data, 0644))
+
+	ob, err := objectbox.NewBuilder().Directory(dir).Model(model.ObjectBoxModel()).
+		ValidateOnOpenPages(1000, objectbox.ValidateOnOpenPagesFlagsVisitLeafPages).BuildOrError()
+	if err == nil {
+		ob.Close()
+	}
+	assert.Err(t, err)
+
+	corruptionErr, isCorrupt := err.(*objectbox.CorruptionError)
+	if !isCorrupt {
+		assert.Failf(t, "expected a *CorruptionError, got %T: %v", err, err)
+	}
+	assert.Eq(t, dir, corruptionErr.Directory)
+	assert.MustMatch(t, regexp.MustCompile("^database in .* is corrupt: "), err)
+
+	if corruptionErr.PagesCorrupt {
+		assertErrorIs(t, err, objectbox.ErrFilePagesCorrupt)
+		assert.True(t, !corruptionErr.Is(objectbox.ErrFileCorrupt))
+	} else {
+		assertErrorIs(t, err, objectbox.ErrFileCorrupt)
+		assert.True(t, !corruptionErr.Is(objectbox.ErrFilePagesCorrupt))
+	}
+	assert.Eq(t, corruptionErr.Cause, corruptionErr.Unwrap())
+}
+
+func TestBackupRestore(t *testing.T) {
+	var dir = prepareDbDir(t, 3)
+	defer os.RemoveAll(dir)
+
+	ob, err := objectbox.NewBuilder().Directory(dir).Model(model.ObjectBoxModel()).BuildOrError()
+	assert.NoErr(t, err)
+	defer ob.Close()
+
+	backupDir, err := ioutil.TempDir("", "objectbox-backup")
+	assert.NoErr(t, err)
+	defer os.RemoveAll(backupDir)
+	var backupFile = filepath.Join(backupDir, "objectbox.backup")
+
+	err = ob.BackUpToFile(backupFile, objectbox.BackupFlagsExcludeSalt|objectbox.BackupFlagsExcludeTimestamp)
+	if !objectbox.BackupIsAvailable() {
+		assert.Err(t, err)
+		return
+	}
+	assert.NoErr(t, err)
+
+	var restore = func(file string, flags objectbox.BackupRestoreFlags) uint64 {
+		restoreDir, err := ioutil.TempDir("", "objectbox-test")
+		assert.NoErr(t, err)
+		defer os.RemoveAll(restoreDir)
+
+		ob, err := objectbox.NewBuilder().Directory(restoreDir).Model(model.ObjectBoxModel()).
+			BackupRestore(file, flags).BuildOrError()
+		assert.NoErr(t, err)
+		defer ob.Close()
+
+		count, err := model.BoxForEntity(ob).Count()
+		assert.NoErr(t, err)
+		return count
+	}
+
+	assert.Eq(t, uint64(3), restore(backupFile, objectbox.BackupRestoreFlagsOnlyIfEmpty))
+	assert.Eq(t, uint64(0), restore(backupFile+".missing", objectbox.BackupRestoreFlagsIgnoreMissingFile))
+}
+
+func TestBuilderUsePreviousCommit(t *testing.T) {
+	var dir = prepareDbDir(t, 1)
+	defer os.RemoveAll(dir)
+
+	// one more commit so that there's a previous one containing a single object
+	ob, err := objectbox.NewBuilder().Directory(dir).Model(model.ObjectBoxModel()).BuildOrError()
+	assert.NoErr(t, err)
+	assert.True(t, !ob.OpenedWithPreviousCommit())
+	_, err = model.BoxForEntity(ob).Put(model.Entity47())
+	assert.NoErr(t, err)
+	ob.Close()
+
+	ob, err = objectbox.NewBuilder().Directory(dir).Model(model.ObjectBoxModel()).
+		UsePreviousCommit().ReadOnly().BuildOrError()
+	assert.NoErr(t, err)
+	defer ob.Close()
+	assert.True(t, ob.OpenedWithPreviousCommit())
+
+	count, err := model.BoxForEntity(ob).Count()
+	assert.NoErr(t, err)
+	assert.Eq(t, uint64(1), count)
+}
+
+func TestBuilderLogCallback(t *testing.T) {
+	var dir = prepareDbDir(t, 0)
+	defer os.RemoveAll(dir)
+
+	var mutex sync.Mutex
+	var levels []objectbox.LogLevel
+	var received = func() []objectbox.LogLevel {
+		mutex.Lock()
+		defer mutex.Unlock()
+		return append([]objectbox.LogLevel{}, levels...)
+	}
+
+	ob, err := objectbox.NewBuilder().Directory(dir).Model(model.ObjectBoxModel()).
+		LogCallback(func(level objectbox.LogLevel, message string) {
+			mutex.Lock()
+			defer mutex.Unlock()
+			levels = append(levels, level)
+		}).BuildOrError()
+	assert.NoErr(t, err)
+
+	_, err = model.BoxForEntity(ob).Put(model.Entity47())
+	assert.NoErr(t, err)
+	ob.Close()
+
+	// at least opening the store is logged
+	var afterClose = received()
+	assert.True(t, len(afterClose) > 0)
+	for _, level := range afterClose {
+		if strings.HasPrefix(level.String(), "LogLevel(") {
+			assert.Failf(t, "unexpected log level %v", level)
+		}
+	}
+
+	// closing the store unregisters the callback, i.e. another store doesn't log to it
+	ob, err = objectbox.NewBuilder().Directory(dir).Model(model.ObjectBoxModel()).BuildOrError()
+	assert.NoErr(t, err)
+	_, err = model.BoxForEntity(ob).Put(model.Entity47())
+	assert.NoErr(t, err)
+	ob.Close()
+
+	assert.Eq(t, afterClose, received())
+}
+
+func TestLogLevelString(t *testing.T) {
+	assert.Eq(t, "VERBOSE", objectbox.LogLevelVerbose.String())
+	assert.Eq(t, "DEBUG", objectbox.LogLevelDebug.String())
+	assert.Eq(t, "INFO", objectbox.LogLevelInfo.String())
+	assert.Eq(t, "WARN", objectbox.LogLevelWarn.String())
+	assert.Eq(t, "ERROR", objectbox.LogLevelError.String())
+	assert.Eq(t, "LogLevel(1)", objectbox.LogLevel(1).String())
+}
+
+func TestBuilderAsyncOptions(t *testing.T) {
+	var dir = prepareDbDir(t, 0)
+	defer os.RemoveAll(dir)
+
+	ob, err := objectbox.NewBuilder().Directory(dir).Model(model.ObjectBoxModel()).
+		AsyncMaxQueueLength(1000).
+		AsyncThrottleAtQueueLength(500).
+		AsyncThrottle(time.Millisecond).
+		AsyncMaxInTxDuration(100 * time.Millisecond).
+		AsyncMaxInTxOperations(100).
+		AsyncPreTxnDelay(100 * time.Microsecond).
+		AsyncPostTxnDelay(100 * time.Microsecond).
+		AsyncObjectBytesMaxCacheSize(1024 * 1024).
+		AsyncObjectBytesMaxSizeToCache(1024).
+		BuildOrError()
+	assert.NoErr(t, err)
+	defer ob.Close()
+
+	var box = model.BoxForTestEntityInline(ob)
+	for i := 0; i < 100; i++ {
+		_, err = box.Async().Put(&model.TestEntityInline{})
+		assert.NoErr(t, err)
+	}
+	assert.NoErr(t, ob.AwaitAsyncSubmitted())
+
+	count, err := box.Count()
+	assert.NoErr(t, err)
+	assert.Eq(t, uint64(100), count)
+}
+
+func TestBuilderAsyncOptionsInvalid(t *testing.T) {
+	var builders = map[string]*objectbox.Builder{
+		"max queue length":  objectbox.NewBuilder().AsyncMaxQueueLength(0),
+		"operations":        objectbox.NewBuilder().AsyncMaxInTxOperations(0),
+		"negative duration": objectbox.NewBuilder().AsyncThrottle(-time.Second),
+		"too long duration": objectbox.NewBuilder().AsyncPreTxnDelay(24 * time.Hour),
+		"throttle-at":       objectbox.NewBuilder().AsyncMaxQueueLength(10).AsyncThrottleAtQueueLength(11),
+		"max size to cache": objectbox.NewBuilder().AsyncObjectBytesMaxCacheSize(10).AsyncObjectBytesMaxSizeToCache(11),
+	}
+
+	for name, builder := range builders {
+		t.Log(name)
+		_, err := builder.Model(model.ObjectBoxModel()).BuildOrError()
+		assert.Err(t, err)
+	}
+}
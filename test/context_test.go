@@ -0,0 +1,356 @@
+/*
+ * Copyright 2018-2021 ObjectBox Ltd. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package objectbox_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/objectbox/objectbox-go/objectbox"
+	"github.com/objectbox/objectbox-go/test/assert"
+	"github.com/objectbox/objectbox-go/test/model"
+)
+
+func TestQueryFindContext(t *testing.T) {
+	var env = model.NewTestEnv(t)
+	defer env.Close()
+	env.Populate(100)
+
+	var query = env.Box.Query()
+
+	objects, err := query.FindContext(context.Background())
+	assert.NoErr(t, err)
+	assert.Eq(t, 100, len(objects.([]*model.Entity)))
+
+	ids, err := query.FindIdsContext(context.Background())
+	assert.NoErr(t, err)
+	assert.Eq(t, 100, len(ids))
+
+	count, err := query.CountContext(context.Background())
+	assert.NoErr(t, err)
+	assert.Eq(t, uint64(100), count)
+
+	// a cancelled context stops the visitor
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	objects, err = query.FindContext(ctx)
+	assert.Eq(t, context.Canceled, err)
+	assert.True(t, objects == nil)
+
+	_, err = query.FindIdsContext(ctx)
+	assert.Eq(t, context.Canceled, err)
+
+	_, err = query.CountContext(ctx)
+	assert.Eq(t, context.Canceled, err)
+}
+
+func TestPropertyQueryContext(t *testing.T) {
+	var env = model.NewTestEnv(t)
+	defer env.Close()
+	env.Populate(10)
+
+	var pq = env.Box.Query().Property(model.Entity_.Int64)
+	defer pq.Close()
+
+	values, err := pq.FindInt64sContext(context.Background(), nil)
+	assert.NoErr(t, err)
+	assert.Eq(t, 10, len(values))
+
+	strings, err := env.Box.Query().Property(model.Entity_.String).FindStringsContext(context.Background(), nil)
+	assert.NoErr(t, err)
+	assert.Eq(t, 10, len(strings))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err = pq.CountContext(ctx)
+	assert.Eq(t, context.Canceled, err)
+
+	_, err = pq.FindInt64sContext(ctx, nil)
+	assert.Eq(t, context.Canceled, err)
+}
+
+func TestBoxContext(t *testing.T) {
+	var env = model.NewTestEnv(t)
+	defer env.Close()
+
+	var objects = make([]*model.Entity, 100)
+	for i := range objects {
+		objects[i] = model.Entity47()
+	}
+
+	ids, err := env.Box.PutManyContext(context.Background(), objects)
+	assert.NoErr(t, err)
+	assert.Eq(t, 100, len(ids))
+
+	all, err := env.Box.GetAllContext(context.Background())
+	assert.NoErr(t, err)
+	assert.Eq(t, 100, len(all.([]*model.Entity)))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	all, err = env.Box.GetAllContext(ctx)
+	assert.Eq(t, context.Canceled, err)
+	assert.True(t, all == nil)
+
+	// nothing is written with a cancelled context
+	for i := range objects {
+		objects[i] = model.Entity47()
+	}
+	ids, err = env.Box.PutManyContext(ctx, objects)
+	assert.Eq(t, context.Canceled, err)
+	assert.True(t, ids == nil)
+
+	count, err := env.Box.Count()
+	assert.NoErr(t, err)
+	assert.Eq(t, uint64(100), count)
+}
+
+func TestAsyncAwaitContext(t *testing.T) {
+	var env = model.NewTestEnv(t)
+	defer env.Close()
+
+	var async = model.AsyncBoxForEntity(env.ObjectBox, 1000)
+	defer async.Close()
+
+	_, err := async.Put(model.Entity47())
+	assert.NoErr(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	assert.NoErr(t, async.AwaitSubmittedContext(ctx))
+	assert.NoErr(t, async.AwaitCompletionContext(ctx))
+	assert.NoErr(t, env.ObjectBox.AwaitAsyncCompletionContext(ctx))
+	assert.NoErr(t, env.ObjectBox.AwaitAsyncSubmittedContext(ctx))
+
+	count, err := env.Box.Count()
+	assert.NoErr(t, err)
+	assert.Eq(t, uint64(1), count)
+
+	// an expired deadline is reported without waiting
+	ctx, cancel2 := context.WithTimeout(context.Background(), time.Nanosecond)
+	defer cancel2()
+	<-ctx.Done()
+	assert.Eq(t, context.DeadlineExceeded, async.AwaitCompletionContext(ctx))
+	assert.Eq(t, context.DeadlineExceeded, env.ObjectBox.AwaitAsyncSubmittedContext(ctx))
+}
+
+func TestQueryContextReuseAfterTimeout(t *testing.T) {
+	var env = model.NewTestEnv(t)
+	defer env.Close() // waits for the abandoned calls before closing the store
+	env.Populate(10000)
+
+	var query = env.Box.Query(model.Entity_.Int64.GreaterThan(0))
+	defer query.Close()
+
+	var pq = query.Property(model.Entity_.Int64)
+	defer pq.Close()
+	assert.NoErr(t, pq.Distinct(true))
+
+	expected, err := query.Count()
+	assert.NoErr(t, err)
+
+	distinct, err := pq.FindInt64s(nil)
+	assert.NoErr(t, err)
+
+	// abandoned native calls run on clones so the query can be used (and changed) right after the timeout
+	for i := 0; i < 20; i++ {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Microsecond)
+		_, err = query.FindIdsContext(ctx)
+		if err != nil {
+			assert.Eq(t, context.DeadlineExceeded, err)
+		}
+		_, err = pq.FindInt64sContext(ctx, nil)
+		if err != nil {
+			assert.Eq(t, context.DeadlineExceeded, err)
+		}
+		cancel()
+
+		count, err := query.Count()
+		assert.NoErr(t, err)
+		assert.Eq(t, expected, count)
+
+		values, err := pq.FindInt64s(nil)
+		assert.NoErr(t, err)
+		assert.Eq(t, len(distinct), len(values))
+
+		assert.NoErr(t, query.SetInt64Params(model.Entity_.Int64, 47))
+		ids, err := query.FindIds()
+		assert.NoErr(t, err)
+		assert.True(t, uint64(len(ids)) <= expected)
+
+		assert.NoErr(t, query.SetInt64Params(model.Entity_.Int64, 0))
+	}
+
+	// the clone also carries the query parameters and the distinct configuration of the property query
+	count, err := query.CountContext(context.Background())
+	assert.NoErr(t, err)
+	assert.Eq(t, expected, count)
+
+	values, err := pq.FindInt64sContext(context.Background(), nil)
+	assert.NoErr(t, err)
+	assert.Eq(t, len(distinct), len(values))
+}
+
+func TestQueryContextInTx(t *testing.T) {
+	var env = model.NewTestEnv(t)
+	defer env.Close()
+	env.Populate(10)
+
+	var query = env.Box.Query()
+	defer query.Close()
+	var pq = query.Property(model.Entity_.Int64)
+	defer pq.Close()
+
+	// inside the transaction, the context variants see its uncommitted changes, the same as the plain methods
+	var rollback = errors.New("rollback")
+	assert.Eq(t, rollback, env.ObjectBox.RunInWriteTx(func() error {
+		_, err := env.Box.Put(model.Entity47())
+		assert.NoErr(t, err)
+
+		count, err := query.Count()
+		assert.NoErr(t, err)
+		assert.Eq(t, uint64(11), count)
+
+		count, err = query.CountContext(context.Background())
+		assert.NoErr(t, err)
+		assert.Eq(t, uint64(11), count)
+
+		ids, err := query.FindIdsContext(context.Background())
+		assert.NoErr(t, err)
+		assert.Eq(t, 11, len(ids))
+
+		count, err = pq.CountContext(context.Background())
+		assert.NoErr(t, err)
+		assert.Eq(t, uint64(11), count)
+		return rollback
+	}))
+
+	count, err := query.CountContext(context.Background())
+	assert.NoErr(t, err)
+	assert.Eq(t, uint64(10), count)
+}
+
+// cancelBinding cancels the context after the given number of objects have been loaded
+type cancelBinding struct {
+	objectbox.ObjectBinding
+	cancelAfter int
+	cancel      context.CancelFunc
+	loaded      int
+}
+
+func (binding *cancelBinding) AfterLoad(ob *objectbox.ObjectBox, object interface{}) error {
+	binding.loaded++
+	if binding.loaded == binding.cancelAfter {
+		binding.cancel()
+	}
+	return nil
+}
+
+func TestQueryFindContextCancelDuringVisit(t *testing.T) {
+	var binding = &cancelBinding{cancelAfter: 10}
+	var m = model.ObjectBoxModel()
+	m.WrapBinding(model.EntityBinding.Id, func(original objectbox.ObjectBinding) objectbox.ObjectBinding {
+		binding.ObjectBinding = original
+		return binding
+	})
+	ob, err := objectbox.NewBuilder().Directory("memory:context-find-cancel").Model(m).BuildOrError()
+	assert.NoErr(t, err)
+	defer ob.Close()
+
+	var box = model.BoxForEntity(ob)
+	var objects = make([]*model.Entity, 100)
+	for i := range objects {
+		objects[i] = model.Entity47()
+	}
+	ids, err := box.PutMany(objects)
+	assert.NoErr(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	binding.cancel = cancel
+
+	found, err := box.Query().FindContext(ctx)
+	assert.Eq(t, context.Canceled, err)
+	assert.True(t, found == nil)
+	assert.Eq(t, binding.cancelAfter, binding.loaded)
+
+	// the same for GetManyContext()
+	ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+	binding.cancel = cancel
+	binding.loaded = 0
+
+	found, err = box.GetManyContext(ctx, ids...)
+	assert.Eq(t, context.Canceled, err)
+	assert.True(t, found == nil)
+	assert.Eq(t, binding.cancelAfter, binding.loaded)
+}
+
+func TestTxContext(t *testing.T) {
+	var env = model.NewTestEnv(t)
+	defer env.Close()
+
+	object, err := env.Box.GetContext(context.Background(), 1)
+	assert.NoErr(t, err)
+	assert.True(t, object == nil)
+
+	// a context cancelled inside the transaction rolls it back
+	ctx, cancel := context.WithCancel(context.Background())
+	assert.Eq(t, context.Canceled, env.ObjectBox.RunInWriteTxContext(ctx, func() error {
+		_, err := env.Box.Put(model.Entity47())
+		assert.NoErr(t, err)
+		cancel()
+		return nil
+	}))
+
+	var called = false
+	assert.Eq(t, context.Canceled, env.ObjectBox.RunInReadTxContext(ctx, func() error {
+		called = true
+		return nil
+	}))
+	assert.True(t, !called)
+
+	count, err := env.Box.Count()
+	assert.NoErr(t, err)
+	assert.Eq(t, uint64(0), count)
+
+	_, err = env.Box.GetContext(ctx, 1)
+	assert.Eq(t, context.Canceled, err)
+
+	// explicit transactions
+	tx, err := env.ObjectBox.BeginWriteTx()
+	assert.NoErr(t, err)
+	_, err = tx.Box(env.Box.Box).Put(model.Entity47())
+	assert.NoErr(t, err)
+	assert.Eq(t, context.Canceled, tx.CommitContext(ctx))
+	assert.True(t, !tx.IsActive())
+
+	tx, err = env.ObjectBox.BeginWriteTx()
+	assert.NoErr(t, err)
+	_, err = tx.Box(env.Box.Box).Put(model.Entity47())
+	assert.NoErr(t, err)
+	assert.NoErr(t, tx.CommitContext(context.Background()))
+
+	count, err = env.Box.Count()
+	assert.NoErr(t, err)
+	assert.Eq(t, uint64(1), count)
+}
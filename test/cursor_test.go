@@ -0,0 +1,120 @@
+/*
+ * Copyright 2018-2021 ObjectBox Ltd. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package objectbox_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/objectbox/objectbox-go/objectbox"
+	"github.com/objectbox/objectbox-go/test/assert"
+	"github.com/objectbox/objectbox-go/test/model"
+)
+
+func TestCursorIterate(t *testing.T) {
+	var env = model.NewTestEnv(t)
+	defer env.Close()
+	env.Populate(10)
+
+	assert.NoErr(t, env.Box.ReadWithCursor(func(cursor *objectbox.Cursor) error {
+		var ids []uint64
+		object, err := cursor.First()
+		for ; object != nil && err == nil; object, err = cursor.Next() {
+			ids = append(ids, object.(*model.Entity).Id)
+		}
+		assert.NoErr(t, err)
+		assert.Eq(t, []uint64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, ids)
+
+		// the same using IDs only
+		ids = nil
+		id, err := cursor.FirstId()
+		for ; id != 0 && err == nil; id, err = cursor.NextId() {
+			ids = append(ids, id)
+		}
+		assert.NoErr(t, err)
+		assert.Eq(t, 10, len(ids))
+		return nil
+	}))
+}
+
+func TestCursorSeek(t *testing.T) {
+	var env = model.NewTestEnv(t)
+	defer env.Close()
+	env.Populate(10)
+
+	assert.NoErr(t, env.Box.ReadWithCursor(func(cursor *objectbox.Cursor) error {
+		found, err := cursor.Seek(5)
+		assert.NoErr(t, err)
+		assert.True(t, found)
+
+		object, err := cursor.Current()
+		assert.NoErr(t, err)
+		assert.Eq(t, uint64(5), object.(*model.Entity).Id)
+
+		object, err = cursor.Next()
+		assert.NoErr(t, err)
+		assert.Eq(t, uint64(6), object.(*model.Entity).Id)
+
+		found, err = cursor.Seek(100)
+		assert.NoErr(t, err)
+		assert.True(t, !found)
+		return nil
+	}))
+}
+
+func TestCursorEmpty(t *testing.T) {
+	var env = model.NewTestEnv(t)
+	defer env.Close()
+
+	assert.NoErr(t, env.Box.ReadWithCursor(func(cursor *objectbox.Cursor) error {
+		object, err := cursor.First()
+		assert.NoErr(t, err)
+		assert.True(t, object == nil)
+
+		id, err := cursor.FirstId()
+		assert.NoErr(t, err)
+		assert.Eq(t, uint64(0), id)
+		return nil
+	}))
+}
+
+func TestCursorTx(t *testing.T) {
+	var env = model.NewTestEnv(t)
+	defer env.Close()
+	env.Populate(3)
+
+	tx, err := env.ObjectBox.BeginReadTx()
+	assert.NoErr(t, err)
+	defer tx.Close()
+
+	cursor, err := tx.Cursor(env.Box.Box)
+	assert.NoErr(t, err)
+
+	object, err := cursor.First()
+	assert.NoErr(t, err)
+	assert.Eq(t, uint64(1), object.(*model.Entity).Id)
+
+	assert.NoErr(t, cursor.Close())
+	assert.NoErr(t, cursor.Close()) // no-op
+
+	_, err = cursor.Next()
+	assert.MustMatch(t, regexp.MustCompile("cursor was closed"), err)
+
+	assert.NoErr(t, tx.Close())
+	_, err = tx.Cursor(env.Box.Box)
+	assert.MustMatch(t, regexp.MustCompile("transaction has already been finished"), err)
+}
@@ -0,0 +1,218 @@
+/*
+ * Copyright 2018-2021 ObjectBox Ltd. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package objectbox_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/objectbox/objectbox-go/objectbox"
+	"github.com/objectbox/objectbox-go/test/assert"
+	"github.com/objectbox/objectbox-go/test/model"
+)
+
+func assertRestricted(t *testing.T, err error, id uint64, sourceIds []uint64) {
+	assert.Err(t, err)
+	restricted, ok := err.(*objectbox.RelationRestrictError)
+	if !ok {
+		assert.Failf(t, "expected a *RelationRestrictError, got %T: %v", err, err)
+	}
+	assert.True(t, restricted.Is(objectbox.ErrRelationRestricted))
+	assert.Eq(t, id, restricted.Id)
+	assert.Eq(t, sourceIds, restricted.SourceIds)
+}
+
+func assertCount(t *testing.T, box interface{ Count() (uint64, error) }, expected uint64) {
+	count, err := box.Count()
+	assert.NoErr(t, err)
+	assert.Eq(t, expected, count)
+}
+
+func TestDeletePolicyModel(t *testing.T) {
+	var m = model.ObjectBoxModel()
+	m.ToOneDeletePolicy(&objectbox.RelationToOne{
+		Property: &objectbox.BaseProperty{Id: 2, Entity: &objectbox.Entity{Id: 1}},
+		Target:   &objectbox.Entity{Id: 5},
+	}, objectbox.DeletePolicyCascade)
+	assert.MustMatch(t, regexp.MustCompile("is not a to-one relation"), m.Error)
+
+	m = model.ObjectBoxModel()
+	m.ToManyDeletePolicy(&objectbox.RelationToMany{
+		Id:     100,
+		Source: &objectbox.Entity{Id: 1},
+		Target: &objectbox.Entity{Id: 5},
+	}, objectbox.DeletePolicyCascade)
+	assert.MustMatch(t, regexp.MustCompile("relation 100 not found"), m.Error)
+}
+
+func TestDeletePolicyToOne(t *testing.T) {
+	var m = model.ObjectBoxModel()
+	m.ToOneDeletePolicy(model.Entity_.Related, objectbox.DeletePolicyNullify)
+	m.ToOneDeletePolicy(model.Entity_.RelatedPtr, objectbox.DeletePolicyCascade)
+	m.ToOneDeletePolicy(model.Entity_.RelatedPtr2, objectbox.DeletePolicyRestrict)
+	ob, err := objectbox.NewBuilder().Directory("memory:delete-policy-to-one").Model(m).BuildOrError()
+	assert.NoErr(t, err)
+	defer ob.Close()
+
+	var box = model.BoxForEntity(ob)
+	var boxR = model.BoxForTestEntityRelated(ob)
+
+	var targets = []*model.TestEntityRelated{{Name: "nullify"}, {Name: "cascade"}, {Name: "restrict"}}
+	_, err = boxR.PutMany(targets)
+	assert.NoErr(t, err)
+
+	var cascaded = &model.Entity{Related: *targets[0], RelatedPtr: targets[1]}
+	var restricting = &model.Entity{Related: *targets[0], RelatedPtr2: targets[2]}
+	_, err = box.PutMany([]*model.Entity{cascaded, restricting})
+	assert.NoErr(t, err)
+
+	// restrict: nothing is removed
+	_, err = boxR.RemoveIds(targets[1].Id, targets[2].Id)
+	assertRestricted(t, err, targets[2].Id, []uint64{restricting.Id})
+	assertCount(t, boxR, 3)
+	assertCount(t, box, 2)
+
+	// nullify
+	assert.NoErr(t, boxR.Remove(targets[0]))
+	read, err := box.Get(restricting.Id)
+	assert.NoErr(t, err)
+	assert.Eq(t, uint64(0), read.Related.Id)
+	assert.Eq(t, targets[2].Id, read.RelatedPtr2.Id)
+
+	// cascade
+	assert.NoErr(t, boxR.RemoveId(targets[1].Id))
+	assertCount(t, box, 1)
+	read, err = box.Get(cascaded.Id)
+	assert.NoErr(t, err)
+	assert.True(t, read == nil)
+
+	// once the restricting object is gone, the target can be removed
+	assert.NoErr(t, box.Remove(restricting))
+	assert.NoErr(t, boxR.Remove(targets[2]))
+	assertCount(t, boxR, 0)
+}
+
+func TestDeletePolicyRemoveAll(t *testing.T) {
+	var m = model.ObjectBoxModel()
+	m.ToOneDeletePolicy(model.Entity_.RelatedPtr, objectbox.DeletePolicyCascade)
+	m.ToManyDeletePolicy(model.Entity_.RelatedPtrSlice, objectbox.DeletePolicyRestrict)
+	ob, err := objectbox.NewBuilder().Directory("memory:delete-policy-remove-all").Model(m).BuildOrError()
+	assert.NoErr(t, err)
+	defer ob.Close()
+
+	var box = model.BoxForEntity(ob)
+	var boxR = model.BoxForTestEntityRelated(ob)
+
+	var targets = []*model.TestEntityRelated{{Name: "a"}, {Name: "b"}, {Name: "c"}}
+	_, err = boxR.PutMany(targets)
+	assert.NoErr(t, err)
+
+	var objects = []*model.Entity{
+		{Related: *targets[0], RelatedPtr: targets[0]},
+		{Related: *targets[0], RelatedPtr: targets[1]},
+		{Related: *targets[0], RelatedPtrSlice: []*model.TestEntityRelated{targets[2]}},
+	}
+	_, err = box.PutMany(objects)
+	assert.NoErr(t, err)
+
+	// query
+	count, err := boxR.Query(model.TestEntityRelated_.Name.Equals("a", true)).Remove()
+	assert.NoErr(t, err)
+	assert.Eq(t, uint64(1), count)
+	assertCount(t, box, 2)
+
+	// restricted by the standalone relation
+	err = boxR.RemoveAll()
+	assertRestricted(t, err, targets[2].Id, []uint64{objects[2].Id})
+	assertCount(t, boxR, 2)
+	assertCount(t, box, 2)
+
+	// without the standalone relation entry, the remaining object pointing to "b" is removed by the cascade
+	objects[2].RelatedPtrSlice = []*model.TestEntityRelated{}
+	_, err = box.Put(objects[2])
+	assert.NoErr(t, err)
+	assert.NoErr(t, boxR.RemoveAll())
+	assertCount(t, boxR, 0)
+	assertCount(t, box, 1)
+}
+
+func TestDeletePolicyDynamicBox(t *testing.T) {
+	var m = model.ObjectBoxModel()
+	m.ToOneDeletePolicy(model.Entity_.RelatedPtr, objectbox.DeletePolicyCascade)
+	m.ToOneDeletePolicy(model.Entity_.RelatedPtr2, objectbox.DeletePolicyRestrict)
+	ob, err := objectbox.NewBuilder().Directory("memory:delete-policy-dynamic").Model(m).BuildOrError()
+	assert.NoErr(t, err)
+	defer ob.Close()
+
+	var box = model.BoxForEntity(ob)
+	dynamicR, err := ob.DynamicBox("TestEntityRelated")
+	assert.NoErr(t, err)
+
+	var targets = []*model.TestEntityRelated{{Name: "cascade"}, {Name: "restrict"}}
+	_, err = model.BoxForTestEntityRelated(ob).PutMany(targets)
+	assert.NoErr(t, err)
+
+	var cascaded = &model.Entity{Related: *targets[0], RelatedPtr: targets[0]}
+	var restricting = &model.Entity{Related: *targets[0], RelatedPtr2: targets[1]}
+	_, err = box.PutMany([]*model.Entity{cascaded, restricting})
+	assert.NoErr(t, err)
+
+	// policies apply the same way when removing through a DynamicBox
+	_, err = dynamicR.RemoveIds(targets[1].Id)
+	assertRestricted(t, err, targets[1].Id, []uint64{restricting.Id})
+	assertCount(t, dynamicR, 2)
+
+	assert.NoErr(t, dynamicR.RemoveId(targets[0].Id))
+	assertCount(t, dynamicR, 1)
+	assertCount(t, box, 1)
+
+	read, err := box.Get(cascaded.Id)
+	assert.NoErr(t, err)
+	assert.True(t, read == nil)
+}
+
+func TestDeletePolicyNullifyVersion(t *testing.T) {
+	var m = model.ObjectBoxModel()
+	m.ToOneDeletePolicy(model.Entity_.RelatedPtr, objectbox.DeletePolicyNullify)
+	m.VersionProperty("Entity", "Int64")
+	ob, err := objectbox.NewBuilder().Directory("memory:delete-policy-nullify-version").Model(m).BuildOrError()
+	assert.NoErr(t, err)
+	defer ob.Close()
+
+	var box = model.BoxForEntity(ob)
+	var boxR = model.BoxForTestEntityRelated(ob)
+
+	var target = &model.TestEntityRelated{Name: "nullify"}
+	_, err = boxR.Put(target)
+	assert.NoErr(t, err)
+
+	var object = &model.Entity{Related: *target, RelatedPtr: target}
+	_, err = box.Put(object)
+	assert.NoErr(t, err)
+	assert.Eq(t, int64(1), object.Int64)
+
+	// nullifying the relation is a change of the object so it increments the version
+	assert.NoErr(t, boxR.Remove(target))
+	read, err := box.Get(object.Id)
+	assert.NoErr(t, err)
+	assert.True(t, read.RelatedPtr == nil)
+	assert.Eq(t, int64(2), read.Int64)
+
+	// ... i.e. a stale copy can't overwrite it
+	object.String = "stale"
+	assertVersionConflict(t, box.Update(object), 2)
+}
@@ -0,0 +1,213 @@
+/*
+ * Copyright 2018-2025 ObjectBox Ltd. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package objectbox_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/objectbox/objectbox-go/objectbox"
+	"github.com/objectbox/objectbox-go/test/assert"
+	"github.com/objectbox/objectbox-go/test/model"
+)
+
+func TestDynamicBoxReadTyped(t *testing.T) {
+	env := model.NewTestEnv(t)
+	defer env.Close()
+
+	var object = model.Entity47()
+	id, err := env.Box.Put(object)
+	assert.NoErr(t, err)
+
+	box, err := env.ObjectBox.DynamicBox("Entity")
+	assert.NoErr(t, err)
+	assert.Eq(t, "Entity", box.Entity().Name)
+
+	read, err := box.Get(id)
+	assert.NoErr(t, err)
+	assert.Eq(t, id, read["Id"])
+	assert.Eq(t, int64(47), read["Int64"])
+	assert.Eq(t, int32(47), read["Int32"])
+	assert.Eq(t, uint16(47), read["Uint16"])
+	assert.Eq(t, uint64(47), read["Uint64"])
+	assert.Eq(t, object.String, read["String"])
+	assert.Eq(t, object.Bool, read["Bool"])
+	assert.Eq(t, object.ByteVector, read["ByteVector"])
+	assert.Eq(t, object.StringVector, read["StringVector"])
+	assert.Eq(t, object.Float64, read["Float64"])
+	assert.Eq(t, object.Float32, read["Float32"])
+	assert.Eq(t, int64(47), read["Date"])
+	assert.Eq(t, uint64(0), read["Related"])
+
+	// nil pointers are not stored
+	_, present := read["IntPtr"]
+	assert.True(t, !present)
+
+	missing, err := box.Get(id + 1)
+	assert.NoErr(t, err)
+	assert.True(t, missing == nil)
+}
+
+func TestDynamicBoxWriteTyped(t *testing.T) {
+	env := model.NewTestEnv(t)
+	defer env.Close()
+
+	box, err := env.ObjectBox.DynamicBox("Entity")
+	assert.NoErr(t, err)
+
+	var object = map[string]interface{}{
+		"String":       "dynamic",
+		"Int":          42,
+		"Int8":         float64(-8), // e.g. from encoding/json
+		"Uint32":       uint32(32),
+		"Bool":         true,
+		"StringVector": []string{"a", "b"},
+		"Float32":      1.5,
+		"IntPtr":       7,
+	}
+	id, err := box.Put(object)
+	assert.NoErr(t, err)
+	assert.Eq(t, id, object["Id"])
+
+	typed, err := env.Box.Get(id)
+	assert.NoErr(t, err)
+	assert.Eq(t, "dynamic", typed.String)
+	assert.Eq(t, 42, typed.Int)
+	assert.Eq(t, int8(-8), typed.Int8)
+	assert.Eq(t, uint32(32), typed.Uint32)
+	assert.Eq(t, true, typed.Bool)
+	assert.Eq(t, []string{"a", "b"}, typed.StringVector)
+	assert.Eq(t, float32(1.5), typed.Float32)
+	assert.Eq(t, 7, *typed.IntPtr)
+
+	// update
+	object["String"] = "updated"
+	assert.NoErr(t, box.Update(object))
+	typed, err = env.Box.Get(id)
+	assert.NoErr(t, err)
+	assert.Eq(t, "updated", typed.String)
+
+	count, err := box.Count()
+	assert.NoErr(t, err)
+	assert.Eq(t, uint64(1), count)
+
+	assert.NoErr(t, box.Remove(object))
+	count, err = env.Box.Count()
+	assert.NoErr(t, err)
+	assert.Eq(t, uint64(0), count)
+}
+
+func TestDynamicBoxInvalid(t *testing.T) {
+	env := model.NewTestEnv(t)
+	defer env.Close()
+
+	_, err := env.ObjectBox.DynamicBox("Missing")
+	assert.Err(t, err)
+
+	box, err := env.ObjectBox.DynamicBox("Entity")
+	assert.NoErr(t, err)
+
+	_, err = box.Put(map[string]interface{}{"Unknown": 1})
+	assert.Eq(t, "unknown property Unknown in entity Entity", err.Error())
+
+	_, err = box.Put(map[string]interface{}{"String": 1})
+	assert.Eq(t, "property Entity.String: unexpected value type int", err.Error())
+
+	_, err = box.Put(map[string]interface{}{"Int8": 1000})
+	assert.Err(t, err)
+
+	_, err = box.Put(map[string]interface{}{"Uint": -1})
+	assert.Err(t, err)
+
+	_, err = box.Put(map[string]interface{}{"Int": 1.5})
+	assert.Err(t, err)
+
+	func() {
+		defer assert.MustPanic(t, regexp.MustCompile("no property Unknown in entity Entity"))
+		box.Property("Unknown")
+	}()
+}
+
+func TestDynamicBoxQuery(t *testing.T) {
+	env := model.NewTestEnv(t)
+	defer env.Close()
+
+	box, err := env.ObjectBox.DynamicBox("Entity")
+	assert.NoErr(t, err)
+
+	var objects = make([]map[string]interface{}, 10)
+	for i := 1; i <= len(objects); i++ {
+		objects[i-1] = map[string]interface{}{
+			"Int64":   i * 47,
+			"String":  fmt.Sprintf("val-%d", i),
+			"Bool":    i%2 == 1,
+			"Float64": float64(i * 10),
+		}
+		if i%2 == 1 {
+			objects[i-1]["String"] = fmt.Sprintf("Val-%d", i)
+		}
+	}
+	_, err = box.PutMany(objects)
+	assert.NoErr(t, err)
+
+	all, err := box.GetAll()
+	assert.NoErr(t, err)
+	assert.Eq(t, 10, len(all))
+
+	query := box.Query(box.PropertyInt64("Int64").GreaterThan(47*5), box.PropertyInt64("Int64").OrderDesc())
+	result, err := query.Find()
+	assert.NoErr(t, err)
+	var found = result.([]map[string]interface{})
+	assert.Eq(t, 5, len(found))
+	assert.Eq(t, int64(47*10), found[0]["Int64"])
+
+	count, err := box.Query(box.PropertyString("String").HasPrefix("Val-", true)).Count()
+	assert.NoErr(t, err)
+	assert.Eq(t, uint64(5), count)
+
+	count, err = box.Query(objectbox.Any(
+		box.PropertyBool("Bool").Equals(true),
+		box.PropertyFloat64("Float64").LessThan(100))).Count()
+	assert.NoErr(t, err)
+	assert.Eq(t, uint64(9), count)
+}
+
+func TestDynamicBoxFromJSONModel(t *testing.T) {
+	var dir = prepareDbDir(t, 3)
+	defer os.RemoveAll(dir)
+
+	jsonModel, err := objectbox.NewModelFromJSONFile("model/objectbox-model.json")
+	assert.NoErr(t, err)
+
+	ob, err := objectbox.NewBuilder().Directory(dir).Model(jsonModel).BuildOrError()
+	assert.NoErr(t, err)
+	defer ob.Close()
+
+	box, err := ob.DynamicBox("Entity")
+	assert.NoErr(t, err)
+
+	all, err := box.GetAll()
+	assert.NoErr(t, err)
+	assert.Eq(t, 3, len(all))
+	assert.Eq(t, int64(47), all[0]["Int64"])
+
+	removed, err := box.Query(box.PropertyInt64("Id").Equals(int64(all[0]["Id"].(uint64)))).Remove()
+	assert.NoErr(t, err)
+	assert.Eq(t, uint64(1), removed)
+}
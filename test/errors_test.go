@@ -0,0 +1,118 @@
+/*
+ * Copyright 2018-2021 ObjectBox Ltd. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package objectbox_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/objectbox/objectbox-go/objectbox"
+	"github.com/objectbox/objectbox-go/test/assert"
+	"github.com/objectbox/objectbox-go/test/model"
+	"github.com/objectbox/objectbox-go/test/model/iot"
+)
+
+// assertErrorIs checks the error matches the sentinel the same way errors.Is() would (not available in Go 1.12)
+func assertErrorIs(t *testing.T, err error, sentinel *objectbox.Error) {
+	assert.Err(t, err)
+	obxErr, ok := err.(interface{ Is(error) bool })
+	if !ok {
+		assert.Failf(t, "expected an ObjectBox error, got %T: %v", err, err)
+	}
+	if !obxErr.Is(sentinel) {
+		assert.Failf(t, "expected error matching %v (code %d), got: %v", sentinel, sentinel.Code, err)
+	}
+}
+
+func TestErrorsBox(t *testing.T) {
+	var env = model.NewTestEnv(t)
+	defer env.Close()
+
+	var object = model.Entity47()
+	assertErrorIs(t, env.Box.Update(object), objectbox.ErrIllegalArgument)
+
+	object.Id = 1
+	assertErrorIs(t, env.Box.Update(object), objectbox.ErrNotFound)
+
+	_, err := env.Box.Insert(object)
+	assert.NoErr(t, err)
+
+	_, err = env.Box.Insert(object)
+	assertErrorIs(t, err, objectbox.ErrIdAlreadyExists)
+}
+
+func TestErrorsUniqueViolation(t *testing.T) {
+	var env = iot.NewTestEnv()
+	defer env.Close()
+
+	var box = iot.BoxForEvent(env.ObjectBox)
+	id, err := box.Put(&iot.Event{Uid: "duplicate"})
+	assert.NoErr(t, err)
+
+	_, err = box.Put(&iot.Event{Uid: "duplicate"})
+	assertErrorIs(t, err, objectbox.ErrUniqueViolation)
+
+	uniqueErr, ok := err.(*objectbox.UniqueViolationError)
+	assert.True(t, ok)
+	assert.True(t, !uniqueErr.Is(objectbox.ErrNotFound))
+	assert.Eq(t, "Event", uniqueErr.Entity)
+	assert.Eq(t, "Uid", uniqueErr.Property)
+	assert.Eq(t, "duplicate", uniqueErr.Value)
+	assert.Eq(t, id, uniqueErr.ExistingId)
+	assert.Eq(t, objectbox.ErrUniqueViolation.Code, uniqueErr.Cause.Code)
+	assert.MustMatch(t, regexp.MustCompile("Event.Uid value duplicate is already used by object ID"), err.Error())
+
+	// the same details are available for PutMany(), regardless of the object position in the batch
+	_, err = box.PutMany([]*iot.Event{{Uid: "first"}, {Uid: "duplicate"}})
+	assertErrorIs(t, err, objectbox.ErrUniqueViolation)
+	uniqueErr, ok = err.(*objectbox.UniqueViolationError)
+	assert.True(t, ok)
+	assert.Eq(t, "duplicate", uniqueErr.Value)
+	assert.Eq(t, id, uniqueErr.ExistingId)
+
+	// duplicates within the same batch are reported too, with the duplicate value instead of an unrelated one
+	_, err = box.PutMany([]*iot.Event{{Uid: "a"}, {Uid: "b"}, {Uid: "b"}})
+	assertErrorIs(t, err, objectbox.ErrUniqueViolation)
+	uniqueErr, ok = err.(*objectbox.UniqueViolationError)
+	assert.True(t, ok)
+	assert.Eq(t, "b", uniqueErr.Value)
+	assert.Eq(t, uint64(0), uniqueErr.ExistingId)
+	assert.MustMatch(t, regexp.MustCompile("Event.Uid value b: "), err.Error())
+
+	count, err := box.Count()
+	assert.NoErr(t, err)
+	assert.Eq(t, uint64(1), count)
+}
+
+func TestErrorsQuery(t *testing.T) {
+	var env = model.NewTestEnv(t)
+	defer env.Close()
+
+	var query = env.Box.Query()
+	assert.NoErr(t, query.Close())
+
+	_, err := query.Find()
+	assertErrorIs(t, err, objectbox.ErrIllegalState)
+
+	_, err = query.Count()
+	assertErrorIs(t, err, objectbox.ErrIllegalState)
+
+	// ErrReadOnly is an illegal-state error too, but only matches itself
+	assert.True(t, !err.(interface{ Is(error) bool }).Is(objectbox.ErrReadOnly))
+	assertErrorIs(t, objectbox.ErrReadOnly, objectbox.ErrIllegalState)
+	assert.True(t, objectbox.ErrReadOnly.(interface{ Is(error) bool }).Is(objectbox.ErrReadOnly))
+}
@@ -0,0 +1,90 @@
+/*
+ * Copyright 2018-2021 ObjectBox Ltd. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package objectbox_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/objectbox/objectbox-go/test/assert"
+	"github.com/objectbox/objectbox-go/test/model"
+)
+
+func TestBoxForEach(t *testing.T) {
+	var env = model.NewTestEnv(t)
+	defer env.Close()
+	env.Populate(10)
+
+	var ids []uint64
+	assert.NoErr(t, env.Box.ForEach(func(object *model.Entity) (bool, error) {
+		ids = append(ids, object.Id)
+		return true, nil
+	}))
+	assert.Eq(t, []uint64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, ids)
+
+	// the untyped variant passes the same objects
+	ids = nil
+	assert.NoErr(t, env.Box.Box.ForEach(func(object interface{}) (bool, error) {
+		ids = append(ids, object.(*model.Entity).Id)
+		return true, nil
+	}))
+	assert.Eq(t, []uint64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, ids)
+
+	// stop early
+	ids = nil
+	assert.NoErr(t, env.Box.ForEach(func(object *model.Entity) (bool, error) {
+		ids = append(ids, object.Id)
+		return len(ids) < 3, nil
+	}))
+	assert.Eq(t, []uint64{1, 2, 3}, ids)
+
+	// an error stops the iteration and is returned
+	var expected = errors.New("expected")
+	var count = 0
+	assert.Eq(t, expected, env.Box.ForEach(func(object *model.Entity) (bool, error) {
+		count++
+		return true, expected
+	}))
+	assert.Eq(t, 1, count)
+}
+
+func TestQueryForEach(t *testing.T) {
+	var env = model.NewTestEnv(t)
+	defer env.Close()
+	env.Populate(10)
+
+	var query = env.Box.Query(model.Entity_.Id.GreaterThan(5))
+
+	var ids []uint64
+	assert.NoErr(t, query.ForEach(func(object *model.Entity) (bool, error) {
+		ids = append(ids, object.Id)
+		return true, nil
+	}))
+	assert.Eq(t, []uint64{6, 7, 8, 9, 10}, ids)
+
+	ids = nil
+	assert.NoErr(t, query.Query.ForEach(func(object interface{}) (bool, error) {
+		ids = append(ids, object.(*model.Entity).Id)
+		return false, nil
+	}))
+	assert.Eq(t, []uint64{6}, ids)
+
+	assert.NoErr(t, query.Close())
+	assert.Err(t, query.ForEach(func(object *model.Entity) (bool, error) {
+		return true, nil
+	}))
+}
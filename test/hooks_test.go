@@ -0,0 +1,159 @@
+/*
+ * Copyright 2018-2021 ObjectBox Ltd. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package objectbox_test
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/objectbox/objectbox-go/objectbox"
+	"github.com/objectbox/objectbox-go/test/assert"
+	"github.com/objectbox/objectbox-go/test/model"
+)
+
+// hookBinding wraps the generated binding, implementing all the binding hooks
+type hookBinding struct {
+	objectbox.ObjectBinding
+	events []string
+}
+
+func (binding *hookBinding) BeforePut(ob *objectbox.ObjectBox, object interface{}) error {
+	var entity = object.(*model.Entity)
+	if entity.String == "invalid" {
+		return errors.New("invalid object")
+	}
+	entity.String = strings.TrimSpace(entity.String)
+	binding.events = append(binding.events, "beforePut:"+entity.String)
+	return nil
+}
+
+func (binding *hookBinding) AfterPut(ob *objectbox.ObjectBox, object interface{}, id uint64) error {
+	var entity = object.(*model.Entity)
+	if entity.Id != id {
+		return fmt.Errorf("ID %d not set on the object, found %d", id, entity.Id)
+	}
+	if entity.String == "fail after put" {
+		return errors.New("after put failed")
+	}
+	binding.events = append(binding.events, fmt.Sprintf("afterPut:%d", id))
+	return nil
+}
+
+func (binding *hookBinding) BeforeRemove(ob *objectbox.ObjectBox, id uint64) error {
+	if id == 1 {
+		return errors.New("object 1 can't be removed")
+	}
+	binding.events = append(binding.events, fmt.Sprintf("beforeRemove:%d", id))
+	return nil
+}
+
+func (binding *hookBinding) AfterLoad(ob *objectbox.ObjectBox, object interface{}) error {
+	binding.events = append(binding.events, fmt.Sprintf("afterLoad:%d", object.(*model.Entity).Id))
+	return nil
+}
+
+// hookModel is the same as model.ObjectBoxModel() but with the Entity binding wrapped
+func hookModel(binding *hookBinding) *objectbox.Model {
+	var m = model.ObjectBoxModel()
+	m.WrapBinding(model.EntityBinding.Id, func(original objectbox.ObjectBinding) objectbox.ObjectBinding {
+		binding.ObjectBinding = original
+		return binding
+	})
+	return m
+}
+
+func TestHooksPut(t *testing.T) {
+	var binding = &hookBinding{}
+	ob, err := objectbox.NewBuilder().Directory("memory:hooks-put").Model(hookModel(binding)).BuildOrError()
+	assert.NoErr(t, err)
+	defer ob.Close()
+	var box = model.BoxForEntity(ob)
+
+	// the hook normalizes the object before it's stored
+	var object = &model.Entity{String: "  trimmed  "}
+	id, err := box.Put(object)
+	assert.NoErr(t, err)
+	assert.Eq(t, "trimmed", object.String)
+	assert.Eq(t, []string{"beforePut:trimmed", fmt.Sprintf("afterPut:%d", id)}, binding.events)
+
+	binding.events = nil
+	read, err := box.Get(id)
+	assert.NoErr(t, err)
+	assert.Eq(t, "trimmed", read.String)
+	assert.Eq(t, []string{fmt.Sprintf("afterLoad:%d", id)}, binding.events)
+
+	// errors abort the write
+	_, err = box.Put(&model.Entity{String: "invalid"})
+	assert.Eq(t, "invalid object", err.Error())
+
+	object = &model.Entity{String: "fail after put"}
+	_, err = box.Put(object)
+	assert.Eq(t, "after put failed", err.Error())
+	assert.Eq(t, uint64(0), object.Id)
+
+	_, err = box.PutMany([]*model.Entity{{String: "valid"}, {String: "invalid"}})
+	assert.Eq(t, "invalid object", err.Error())
+
+	count, err := box.Count()
+	assert.NoErr(t, err)
+	assert.Eq(t, uint64(1), count)
+
+	binding.events = nil
+	ids, err := box.PutMany([]*model.Entity{{String: "a "}, {String: " b"}})
+	assert.NoErr(t, err)
+	assert.Eq(t, []string{"beforePut:a", "beforePut:b", fmt.Sprintf("afterPut:%d", ids[0]),
+		fmt.Sprintf("afterPut:%d", ids[1])}, binding.events)
+}
+
+func TestHooksRemove(t *testing.T) {
+	var binding = &hookBinding{}
+	ob, err := objectbox.NewBuilder().Directory("memory:hooks-remove").Model(hookModel(binding)).BuildOrError()
+	assert.NoErr(t, err)
+	defer ob.Close()
+	var box = model.BoxForEntity(ob)
+
+	_, err = box.PutMany([]*model.Entity{{}, {}, {}})
+	assert.NoErr(t, err)
+
+	binding.events = nil
+	assert.NoErr(t, box.RemoveId(3))
+	assert.Eq(t, []string{"beforeRemove:3"}, binding.events)
+
+	err = box.RemoveId(1)
+	assert.Eq(t, "object 1 can't be removed", err.Error())
+
+	// a single failing hook aborts removing all of the objects
+	_, err = box.RemoveIds(2, 1)
+	assert.Eq(t, "object 1 can't be removed", err.Error())
+
+	count, err := box.Count()
+	assert.NoErr(t, err)
+	assert.Eq(t, uint64(2), count)
+}
+
+func TestHooksWrapBindingModel(t *testing.T) {
+	var m = model.ObjectBoxModel()
+	m.WrapBinding(100, func(binding objectbox.ObjectBinding) objectbox.ObjectBinding { return binding })
+	assert.MustMatch(t, regexp.MustCompile("entity 100 not found"), m.Error)
+
+	m = model.ObjectBoxModel()
+	m.WrapBinding(model.EntityBinding.Id, func(binding objectbox.ObjectBinding) objectbox.ObjectBinding { return nil })
+	assert.MustMatch(t, regexp.MustCompile("nil returned for entity Entity"), m.Error)
+}
@@ -0,0 +1,45 @@
+//go:build go1.21
+// +build go1.21
+
+/*
+ * Copyright 2018-2025 ObjectBox Ltd. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package objectbox_test
+
+import (
+	"bytes"
+	"log/slog"
+	"regexp"
+	"testing"
+
+	"github.com/objectbox/objectbox-go/objectbox"
+	"github.com/objectbox/objectbox-go/test/assert"
+)
+
+func TestLogCallbackSlog(t *testing.T) {
+	var buffer bytes.Buffer
+	var logger = slog.New(slog.NewTextHandler(&buffer, &slog.HandlerOptions{Level: slog.LevelInfo}))
+	var callback = objectbox.LogCallbackSlog(logger)
+
+	callback(objectbox.LogLevelDebug, "filtered out")
+	callback(objectbox.LogLevelWarn, "warning message")
+	callback(objectbox.LogLevelError, "error message")
+
+	var output = buffer.String()
+	assert.True(t, !bytes.Contains(buffer.Bytes(), []byte("filtered out")))
+	assert.MustMatch(t, regexp.MustCompile(`level=WARN msg="warning message"`), output)
+	assert.MustMatch(t, regexp.MustCompile(`level=ERROR msg="error message"`), output)
+}
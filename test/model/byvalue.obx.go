@@ -225,6 +225,14 @@ func (box *EntityByValueBox) GetAll() ([]EntityByValue, error) {
 	return objects.([]EntityByValue), nil
 }
 
+// ForEach reads all stored objects one by one, passing each to fn, as opposed to GetAll() which reads all at once.
+// Return false from fn to stop the iteration early; fn is called inside a read transaction.
+func (box *EntityByValueBox) ForEach(fn func(*EntityByValue) (bool, error)) error {
+	return box.Box.ForEach(func(object interface{}) (bool, error) {
+		return fn(object.(*EntityByValue))
+	})
+}
+
 // Remove deletes a single object
 func (box *EntityByValueBox) Remove(object *EntityByValue) error {
 	return box.Box.Remove(object)
@@ -342,6 +350,14 @@ func (query *EntityByValueQuery) Find() ([]EntityByValue, error) {
 	return objects.([]EntityByValue), nil
 }
 
+// ForEach calls fn for each object matching the query, loading them one by one, as opposed to Find().
+// Return false from fn to stop the iteration early; fn is called inside a read transaction.
+func (query *EntityByValueQuery) ForEach(fn func(*EntityByValue) (bool, error)) error {
+	return query.Query.ForEach(func(object interface{}) (bool, error) {
+		return fn(object.(*EntityByValue))
+	})
+}
+
 // Offset defines the index of the first object to process (how many objects to skip)
 func (query *EntityByValueQuery) Offset(offset uint64) *EntityByValueQuery {
 	query.Query.Offset(offset)
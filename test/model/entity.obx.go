@@ -836,6 +836,14 @@ func (box *EntityBox) GetAll() ([]*Entity, error) {
 	return objects.([]*Entity), nil
 }
 
+// ForEach reads all stored objects one by one, passing each to fn, as opposed to GetAll() which reads all at once.
+// Return false from fn to stop the iteration early; fn is called inside a read transaction.
+func (box *EntityBox) ForEach(fn func(*Entity) (bool, error)) error {
+	return box.Box.ForEach(func(object interface{}) (bool, error) {
+		return fn(object.(*Entity))
+	})
+}
+
 // FetchRelatedPtrSlice reads target objects for relation Entity::RelatedPtrSlice.
 // It will "GetManyExisting()" all related TestEntityRelated objects for each source object
 // and set sourceObject.RelatedPtrSlice to the slice of related objects, as currently stored in DB.
@@ -981,6 +989,14 @@ func (query *EntityQuery) Find() ([]*Entity, error) {
 	return objects.([]*Entity), nil
 }
 
+// ForEach calls fn for each object matching the query, loading them one by one, as opposed to Find().
+// Return false from fn to stop the iteration early; fn is called inside a read transaction.
+func (query *EntityQuery) ForEach(fn func(*Entity) (bool, error)) error {
+	return query.Query.ForEach(func(object interface{}) (bool, error) {
+		return fn(object.(*Entity))
+	})
+}
+
 // Offset defines the index of the first object to process (how many objects to skip)
 func (query *EntityQuery) Offset(offset uint64) *EntityQuery {
 	query.Query.Offset(offset)
@@ -1183,6 +1199,14 @@ func (box *TestStringIdEntityBox) GetAll() ([]*TestStringIdEntity, error) {
 	return objects.([]*TestStringIdEntity), nil
 }
 
+// ForEach reads all stored objects one by one, passing each to fn, as opposed to GetAll() which reads all at once.
+// Return false from fn to stop the iteration early; fn is called inside a read transaction.
+func (box *TestStringIdEntityBox) ForEach(fn func(*TestStringIdEntity) (bool, error)) error {
+	return box.Box.ForEach(func(object interface{}) (bool, error) {
+		return fn(object.(*TestStringIdEntity))
+	})
+}
+
 // Remove deletes a single object
 func (box *TestStringIdEntityBox) Remove(object *TestStringIdEntity) error {
 	return box.Box.Remove(object)
@@ -1304,6 +1328,14 @@ func (query *TestStringIdEntityQuery) Find() ([]*TestStringIdEntity, error) {
 	return objects.([]*TestStringIdEntity), nil
 }
 
+// ForEach calls fn for each object matching the query, loading them one by one, as opposed to Find().
+// Return false from fn to stop the iteration early; fn is called inside a read transaction.
+func (query *TestStringIdEntityQuery) ForEach(fn func(*TestStringIdEntity) (bool, error)) error {
+	return query.Query.ForEach(func(object interface{}) (bool, error) {
+		return fn(object.(*TestStringIdEntity))
+	})
+}
+
 // Offset defines the index of the first object to process (how many objects to skip)
 func (query *TestStringIdEntityQuery) Offset(offset uint64) *TestStringIdEntityQuery {
 	query.Query.Offset(offset)
@@ -1529,6 +1561,14 @@ func (box *TestEntityInlineBox) GetAll() ([]*TestEntityInline, error) {
 	return objects.([]*TestEntityInline), nil
 }
 
+// ForEach reads all stored objects one by one, passing each to fn, as opposed to GetAll() which reads all at once.
+// Return false from fn to stop the iteration early; fn is called inside a read transaction.
+func (box *TestEntityInlineBox) ForEach(fn func(*TestEntityInline) (bool, error)) error {
+	return box.Box.ForEach(func(object interface{}) (bool, error) {
+		return fn(object.(*TestEntityInline))
+	})
+}
+
 // Remove deletes a single object
 func (box *TestEntityInlineBox) Remove(object *TestEntityInline) error {
 	return box.Box.Remove(object)
@@ -1646,6 +1686,14 @@ func (query *TestEntityInlineQuery) Find() ([]*TestEntityInline, error) {
 	return objects.([]*TestEntityInline), nil
 }
 
+// ForEach calls fn for each object matching the query, loading them one by one, as opposed to Find().
+// Return false from fn to stop the iteration early; fn is called inside a read transaction.
+func (query *TestEntityInlineQuery) ForEach(fn func(*TestEntityInline) (bool, error)) error {
+	return query.Query.ForEach(func(object interface{}) (bool, error) {
+		return fn(object.(*TestEntityInline))
+	})
+}
+
 // Offset defines the index of the first object to process (how many objects to skip)
 func (query *TestEntityInlineQuery) Offset(offset uint64) *TestEntityInlineQuery {
 	query.Query.Offset(offset)
@@ -1920,6 +1968,14 @@ func (box *TestEntityRelatedBox) GetAll() ([]*TestEntityRelated, error) {
 	return objects.([]*TestEntityRelated), nil
 }
 
+// ForEach reads all stored objects one by one, passing each to fn, as opposed to GetAll() which reads all at once.
+// Return false from fn to stop the iteration early; fn is called inside a read transaction.
+func (box *TestEntityRelatedBox) ForEach(fn func(*TestEntityRelated) (bool, error)) error {
+	return box.Box.ForEach(func(object interface{}) (bool, error) {
+		return fn(object.(*TestEntityRelated))
+	})
+}
+
 // Remove deletes a single object
 func (box *TestEntityRelatedBox) Remove(object *TestEntityRelated) error {
 	return box.Box.Remove(object)
@@ -2037,6 +2093,14 @@ func (query *TestEntityRelatedQuery) Find() ([]*TestEntityRelated, error) {
 	return objects.([]*TestEntityRelated), nil
 }
 
+// ForEach calls fn for each object matching the query, loading them one by one, as opposed to Find().
+// Return false from fn to stop the iteration early; fn is called inside a read transaction.
+func (query *TestEntityRelatedQuery) ForEach(fn func(*TestEntityRelated) (bool, error)) error {
+	return query.Query.ForEach(func(object interface{}) (bool, error) {
+		return fn(object.(*TestEntityRelated))
+	})
+}
+
 // Offset defines the index of the first object to process (how many objects to skip)
 func (query *TestEntityRelatedQuery) Offset(offset uint64) *TestEntityRelatedQuery {
 	query.Query.Offset(offset)
@@ -2248,6 +2312,14 @@ func (box *TestEntitySyncedBox) GetAll() ([]*TestEntitySynced, error) {
 	return objects.([]*TestEntitySynced), nil
 }
 
+// ForEach reads all stored objects one by one, passing each to fn, as opposed to GetAll() which reads all at once.
+// Return false from fn to stop the iteration early; fn is called inside a read transaction.
+func (box *TestEntitySyncedBox) ForEach(fn func(*TestEntitySynced) (bool, error)) error {
+	return box.Box.ForEach(func(object interface{}) (bool, error) {
+		return fn(object.(*TestEntitySynced))
+	})
+}
+
 // Remove deletes a single object
 func (box *TestEntitySyncedBox) Remove(object *TestEntitySynced) error {
 	return box.Box.Remove(object)
@@ -2365,6 +2437,14 @@ func (query *TestEntitySyncedQuery) Find() ([]*TestEntitySynced, error) {
 	return objects.([]*TestEntitySynced), nil
 }
 
+// ForEach calls fn for each object matching the query, loading them one by one, as opposed to Find().
+// Return false from fn to stop the iteration early; fn is called inside a read transaction.
+func (query *TestEntitySyncedQuery) ForEach(fn func(*TestEntitySynced) (bool, error)) error {
+	return query.Query.ForEach(func(object interface{}) (bool, error) {
+		return fn(object.(*TestEntitySynced))
+	})
+}
+
 // Offset defines the index of the first object to process (how many objects to skip)
 func (query *TestEntitySyncedQuery) Offset(offset uint64) *TestEntitySyncedQuery {
 	query.Query.Offset(offset)
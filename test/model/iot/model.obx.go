@@ -242,6 +242,14 @@ func (box *EventBox) GetAll() ([]*Event, error) {
 	return objects.([]*Event), nil
 }
 
+// ForEach reads all stored objects one by one, passing each to fn, as opposed to GetAll() which reads all at once.
+// Return false from fn to stop the iteration early; fn is called inside a read transaction.
+func (box *EventBox) ForEach(fn func(*Event) (bool, error)) error {
+	return box.Box.ForEach(func(object interface{}) (bool, error) {
+		return fn(object.(*Event))
+	})
+}
+
 // Remove deletes a single object
 func (box *EventBox) Remove(object *Event) error {
 	return box.Box.Remove(object)
@@ -359,6 +367,14 @@ func (query *EventQuery) Find() ([]*Event, error) {
 	return objects.([]*Event), nil
 }
 
+// ForEach calls fn for each object matching the query, loading them one by one, as opposed to Find().
+// Return false from fn to stop the iteration early; fn is called inside a read transaction.
+func (query *EventQuery) ForEach(fn func(*Event) (bool, error)) error {
+	return query.Query.ForEach(func(object interface{}) (bool, error) {
+		return fn(object.(*Event))
+	})
+}
+
 // Offset defines the index of the first object to process (how many objects to skip)
 func (query *EventQuery) Offset(offset uint64) *EventQuery {
 	query.Query.Offset(offset)
@@ -645,6 +661,14 @@ func (box *ReadingBox) GetAll() ([]*Reading, error) {
 	return objects.([]*Reading), nil
 }
 
+// ForEach reads all stored objects one by one, passing each to fn, as opposed to GetAll() which reads all at once.
+// Return false from fn to stop the iteration early; fn is called inside a read transaction.
+func (box *ReadingBox) ForEach(fn func(*Reading) (bool, error)) error {
+	return box.Box.ForEach(func(object interface{}) (bool, error) {
+		return fn(object.(*Reading))
+	})
+}
+
 // Remove deletes a single object
 func (box *ReadingBox) Remove(object *Reading) error {
 	return box.Box.Remove(object)
@@ -762,6 +786,14 @@ func (query *ReadingQuery) Find() ([]*Reading, error) {
 	return objects.([]*Reading), nil
 }
 
+// ForEach calls fn for each object matching the query, loading them one by one, as opposed to Find().
+// Return false from fn to stop the iteration early; fn is called inside a read transaction.
+func (query *ReadingQuery) ForEach(fn func(*Reading) (bool, error)) error {
+	return query.Query.ForEach(func(object interface{}) (bool, error) {
+		return fn(object.(*Reading))
+	})
+}
+
 // Offset defines the index of the first object to process (how many objects to skip)
 func (query *ReadingQuery) Offset(offset uint64) *ReadingQuery {
 	query.Query.Offset(offset)
@@ -221,6 +221,14 @@ func (box *TSDateBox) GetAll() ([]*TSDate, error) {
 	return objects.([]*TSDate), nil
 }
 
+// ForEach reads all stored objects one by one, passing each to fn, as opposed to GetAll() which reads all at once.
+// Return false from fn to stop the iteration early; fn is called inside a read transaction.
+func (box *TSDateBox) ForEach(fn func(*TSDate) (bool, error)) error {
+	return box.Box.ForEach(func(object interface{}) (bool, error) {
+		return fn(object.(*TSDate))
+	})
+}
+
 // Remove deletes a single object
 func (box *TSDateBox) Remove(object *TSDate) error {
 	return box.Box.Remove(object)
@@ -338,6 +346,14 @@ func (query *TSDateQuery) Find() ([]*TSDate, error) {
 	return objects.([]*TSDate), nil
 }
 
+// ForEach calls fn for each object matching the query, loading them one by one, as opposed to Find().
+// Return false from fn to stop the iteration early; fn is called inside a read transaction.
+func (query *TSDateQuery) ForEach(fn func(*TSDate) (bool, error)) error {
+	return query.Query.ForEach(func(object interface{}) (bool, error) {
+		return fn(object.(*TSDate))
+	})
+}
+
 // Offset defines the index of the first object to process (how many objects to skip)
 func (query *TSDateQuery) Offset(offset uint64) *TSDateQuery {
 	query.Query.Offset(offset)
@@ -561,6 +577,14 @@ func (box *TSDateNanoBox) GetAll() ([]*TSDateNano, error) {
 	return objects.([]*TSDateNano), nil
 }
 
+// ForEach reads all stored objects one by one, passing each to fn, as opposed to GetAll() which reads all at once.
+// Return false from fn to stop the iteration early; fn is called inside a read transaction.
+func (box *TSDateNanoBox) ForEach(fn func(*TSDateNano) (bool, error)) error {
+	return box.Box.ForEach(func(object interface{}) (bool, error) {
+		return fn(object.(*TSDateNano))
+	})
+}
+
 // Remove deletes a single object
 func (box *TSDateNanoBox) Remove(object *TSDateNano) error {
 	return box.Box.Remove(object)
@@ -678,6 +702,14 @@ func (query *TSDateNanoQuery) Find() ([]*TSDateNano, error) {
 	return objects.([]*TSDateNano), nil
 }
 
+// ForEach calls fn for each object matching the query, loading them one by one, as opposed to Find().
+// Return false from fn to stop the iteration early; fn is called inside a read transaction.
+func (query *TSDateNanoQuery) ForEach(fn func(*TSDateNano) (bool, error)) error {
+	return query.Query.ForEach(func(object interface{}) (bool, error) {
+		return fn(object.(*TSDateNano))
+	})
+}
+
 // Offset defines the index of the first object to process (how many objects to skip)
 func (query *TSDateNanoQuery) Offset(offset uint64) *TSDateNanoQuery {
 	query.Query.Offset(offset)
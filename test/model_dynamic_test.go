@@ -0,0 +1,188 @@
+/*
+ * Copyright 2018-2025 ObjectBox Ltd. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package objectbox_test
+
+import (
+	"os"
+	"testing"
+
+	flatbuffers "github.com/google/flatbuffers/go"
+	"github.com/objectbox/objectbox-go/objectbox"
+	"github.com/objectbox/objectbox-go/test/assert"
+	"github.com/objectbox/objectbox-go/test/model"
+)
+
+func TestModelFromJSON(t *testing.T) {
+	var dir = prepareDbDir(t, 3)
+	defer os.RemoveAll(dir)
+
+	jsonModel, err := objectbox.NewModelFromJSONFile("model/objectbox-model.json")
+	assert.NoErr(t, err)
+
+	ob, err := objectbox.NewBuilder().Directory(dir).Model(jsonModel).BuildOrError()
+	assert.NoErr(t, err)
+	defer ob.Close()
+
+	// compare to the schema declared by the generated code
+	env := model.NewTestEnv(t)
+	defer env.Close()
+	assert.Eq(t, env.ObjectBox.Schema(), ob.Schema())
+
+	var box = ob.InternalBox(model.EntityBinding.Id)
+	count, err := box.Count()
+	assert.NoErr(t, err)
+	assert.Eq(t, uint64(3), count)
+
+	// without generated code, the untyped box works with the same objects as DynamicBox
+	object, err := box.Get(1)
+	assert.NoErr(t, err)
+	assert.Eq(t, "Val-1", object.(map[string]interface{})["String"])
+
+	objects, err := box.GetAll()
+	assert.NoErr(t, err)
+	assert.Eq(t, 3, len(objects.([]map[string]interface{})))
+
+	_, err = box.Put(map[string]interface{}{"String": "json"})
+	assert.NoErr(t, err)
+
+	// the generated properties only carry IDs so they can be used in conditions, the same as with the typed box
+	objects, err = box.Query(model.Entity_.String.Equals("json", true)).Find()
+	assert.NoErr(t, err)
+	assert.Eq(t, 1, len(objects.([]map[string]interface{})))
+}
+
+func TestModelFromJSONInvalid(t *testing.T) {
+	_, err := objectbox.NewModelFromJSON([]byte("{"))
+	assert.Err(t, err)
+
+	_, err = objectbox.NewModelFromJSONFile("model/missing.json")
+	assert.Err(t, err)
+
+	_, err = objectbox.NewModelFromJSON([]byte(`{"lastEntityId": "1"}`))
+	assert.Eq(t, "invalid model JSON lastEntityId: 1 doesn't match the expected format ID:UID", err.Error())
+
+	_, err = objectbox.NewModelFromJSON([]byte(`{"lastEntityId": "1:2", "entities": [{"id": "x:1", "name": "A"}]}`))
+	assert.Err(t, err)
+
+	// missing lastEntityId is reported by the model validation
+	_, err = objectbox.NewModelFromJSON([]byte(`{"entities": []}`))
+	assert.Eq(t, "last entity ID/UID is missing", err.Error())
+}
+
+func TestModelBytes(t *testing.T) {
+	var dir = prepareDbDir(t, 2)
+	defer os.RemoveAll(dir)
+
+	env := model.NewTestEnv(t)
+	defer env.Close()
+
+	var modelBytes = modelBytesFromSchema(env.ObjectBox.Schema())
+
+	ob, err := objectbox.NewBuilder().Directory(dir).ModelBytes(modelBytes).BuildOrError()
+	assert.NoErr(t, err)
+	defer ob.Close()
+	assert.Eq(t, env.ObjectBox.Schema(), ob.Schema())
+
+	count, err := ob.InternalBox(model.EntityBinding.Id).Count()
+	assert.NoErr(t, err)
+	assert.Eq(t, uint64(2), count)
+}
+
+func TestModelBytesInvalid(t *testing.T) {
+	var builder = objectbox.NewBuilder().ModelBytes([]byte{1})
+	assert.Err(t, builder.Error)
+
+	builder = objectbox.NewBuilder().ModelBytes([]byte{1, 2, 3, 4, 5, 6, 7, 8})
+	assert.Err(t, builder.Error)
+}
+
+// modelBytesFromSchema serializes the schema to the FlatBuffers model format accepted by Builder.ModelBytes()
+func modelBytesFromSchema(schema *objectbox.Schema) []byte {
+	var fbb = flatbuffers.NewBuilder(1024)
+
+	var prependIdUid = func(slot int, id objectbox.TypeId, uid uint64) {
+		if id == 0 {
+			return
+		}
+		fbb.Prep(8, 16)
+		fbb.PrependUint64(uid)
+		fbb.Pad(4)
+		fbb.PrependUint32(uint32(id))
+		fbb.PrependStructSlot(slot, fbb.Offset(), 0)
+	}
+
+	var createVector = func(offsets []flatbuffers.UOffsetT) flatbuffers.UOffsetT {
+		fbb.StartVector(flatbuffers.SizeUOffsetT, len(offsets), flatbuffers.SizeUOffsetT)
+		for i := len(offsets) - 1; i >= 0; i-- {
+			fbb.PrependUOffsetT(offsets[i])
+		}
+		return fbb.EndVector(len(offsets))
+	}
+
+	var entities []flatbuffers.UOffsetT
+	for _, entity := range schema.Entities {
+		var properties []flatbuffers.UOffsetT
+		for _, property := range entity.Properties {
+			var name = fbb.CreateString(property.Name)
+			var target flatbuffers.UOffsetT
+			if len(property.RelationTarget) > 0 {
+				target = fbb.CreateString(property.RelationTarget)
+			}
+
+			fbb.StartObject(6)
+			prependIdUid(0, property.Id, property.Uid)
+			fbb.PrependUOffsetTSlot(1, name, 0)
+			fbb.PrependUint16Slot(2, uint16(property.Type), 0)
+			fbb.PrependUint32Slot(3, uint32(property.Flags), 0)
+			prependIdUid(4, property.IndexId, property.IndexUid)
+			fbb.PrependUOffsetTSlot(5, target, 0)
+			properties = append(properties, fbb.EndObject())
+		}
+
+		var relations []flatbuffers.UOffsetT
+		for _, relation := range entity.Relations {
+			fbb.StartObject(3)
+			prependIdUid(0, relation.Id, relation.Uid)
+			prependIdUid(2, relation.TargetEntityId, relation.TargetEntityUid)
+			relations = append(relations, fbb.EndObject())
+		}
+
+		var name = fbb.CreateString(entity.Name)
+		var propertiesVector = createVector(properties)
+		var relationsVector = createVector(relations)
+
+		fbb.StartObject(6)
+		prependIdUid(0, entity.Id, entity.Uid)
+		fbb.PrependUOffsetTSlot(1, name, 0)
+		fbb.PrependUOffsetTSlot(2, propertiesVector, 0)
+		prependIdUid(3, entity.LastPropertyId, entity.LastPropertyUid)
+		fbb.PrependUOffsetTSlot(4, relationsVector, 0)
+		fbb.PrependUint32Slot(5, uint32(entity.Flags), 0)
+		entities = append(entities, fbb.EndObject())
+	}
+
+	var entitiesVector = createVector(entities)
+
+	fbb.StartObject(8)
+	fbb.PrependUOffsetTSlot(3, entitiesVector, 0)
+	prependIdUid(4, schema.LastEntityId, schema.LastEntityUid)
+	prependIdUid(5, schema.LastIndexId, schema.LastIndexUid)
+	prependIdUid(7, schema.LastRelationId, schema.LastRelationUid)
+	fbb.Finish(fbb.EndObject())
+
+	return fbb.FinishedBytes()
+}
@@ -238,6 +238,14 @@ func (box *EntityBox) GetAll() ([]*Entity, error) {
 	return objects.([]*Entity), nil
 }
 
+// ForEach reads all stored objects one by one, passing each to fn, as opposed to GetAll() which reads all at once.
+// Return false from fn to stop the iteration early; fn is called inside a read transaction.
+func (box *EntityBox) ForEach(fn func(*Entity) (bool, error)) error {
+	return box.Box.ForEach(func(object interface{}) (bool, error) {
+		return fn(object.(*Entity))
+	})
+}
+
 // Remove deletes a single object
 func (box *EntityBox) Remove(object *Entity) error {
 	return box.Box.Remove(object)
@@ -355,6 +363,14 @@ func (query *EntityQuery) Find() ([]*Entity, error) {
 	return objects.([]*Entity), nil
 }
 
+// ForEach calls fn for each object matching the query, loading them one by one, as opposed to Find().
+// Return false from fn to stop the iteration early; fn is called inside a read transaction.
+func (query *EntityQuery) ForEach(fn func(*Entity) (bool, error)) error {
+	return query.Query.ForEach(func(object interface{}) (bool, error) {
+		return fn(object.(*Entity))
+	})
+}
+
 // Offset defines the index of the first object to process (how many objects to skip)
 func (query *EntityQuery) Offset(offset uint64) *EntityQuery {
 	query.Query.Offset(offset)
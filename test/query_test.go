@@ -400,6 +400,96 @@ func TestQueryParams(t *testing.T) {
 	})
 }
 
+func TestQueryRelationCount(t *testing.T) {
+	env := model.NewTestEnv(t)
+	defer env.Close()
+
+	var E = model.Entity_
+	var R = model.TestEntityRelated_
+	var boxR = model.BoxForTestEntityRelated(env.ObjectBox)
+
+	// targets with 0, 1, 2 and 3 source objects pointing at them
+	var targets = []*model.TestEntityRelated{{Name: "zero"}, {Name: "one"}, {Name: "two"}, {Name: "three"}}
+	_, err := boxR.PutMany(targets)
+	assert.NoErr(t, err)
+
+	// note: Related is set as well, otherwise a new (empty) TestEntityRelated would be inserted for it
+	for i, target := range targets {
+		for j := 0; j < i; j++ {
+			_, err = env.Box.Put(&model.Entity{Related: *target, RelatedPtr: target})
+			assert.NoErr(t, err)
+		}
+	}
+
+	var findNames = func(conditions ...objectbox.Condition) []string {
+		objects, err := boxR.Query(conditions...).Find()
+		assert.NoErr(t, err)
+		var names = make([]string, len(objects))
+		for i, object := range objects {
+			names[i] = object.Name
+		}
+		return names
+	}
+
+	var count = E.RelatedPtr.Count()
+	assert.Eq(t, []string{"zero"}, findNames(count.Equals(0)))
+	assert.Eq(t, []string{"two"}, findNames(count.Equals(2)))
+	assert.Eq(t, []string{"zero", "one"}, findNames(count.LessThan(2)))
+	assert.Eq(t, []string{"zero", "one", "two"}, findNames(count.LessOrEqual(2)))
+	assert.Eq(t, []string{"three"}, findNames(count.GreaterThan(2)))
+	assert.Eq(t, []string{"two", "three"}, findNames(count.GreaterOrEqual(2)))
+	assert.Eq(t, []string{"zero", "one", "two", "three"}, findNames(count.GreaterOrEqual(0)))
+	assert.Eq(t, []string{}, findNames(count.GreaterThan(3)))
+
+	// combined with other conditions
+	assert.Eq(t, []string{"one", "three"}, findNames(objectbox.Any(count.Equals(1), count.Equals(3))))
+	assert.Eq(t, []string{"two"}, findNames(objectbox.All(count.GreaterOrEqual(1), R.Name.HasPrefix("t", true),
+		count.LessThan(3))))
+
+	// the relation must point to the entity of the query
+	_, err = env.Box.QueryOrError(count.Equals(1))
+	assert.MustMatch(t, regexp.MustCompile("relation targeting a different entity"), err)
+
+	_, err = boxR.QueryOrError(count.LessThan(0))
+	assert.MustMatch(t, regexp.MustCompile("can't be less than 0"), err)
+
+	_, err = boxR.QueryOrError(count.GreaterThan(1).Alias("count"))
+	assert.MustMatch(t, regexp.MustCompile("on a relation count condition is not supported"), err)
+
+	// a reused query counts the related objects each time it's executed
+	var atLeastTwo = boxR.Query(count.GreaterOrEqual(2))
+	defer atLeastTwo.Close()
+	var lessThanTwo = boxR.Query(count.LessThan(2))
+	defer lessThanTwo.Close()
+
+	ids, err := atLeastTwo.FindIds()
+	assert.NoErr(t, err)
+	assert.Eq(t, []uint64{targets[2].Id, targets[3].Id}, ids)
+
+	_, err = env.Box.Put(&model.Entity{Related: *targets[1], RelatedPtr: targets[1]})
+	assert.NoErr(t, err)
+
+	ids, err = atLeastTwo.FindIds()
+	assert.NoErr(t, err)
+	assert.Eq(t, []uint64{targets[1].Id, targets[2].Id, targets[3].Id}, ids)
+
+	counted, err := lessThanTwo.Count()
+	assert.NoErr(t, err)
+	assert.Eq(t, uint64(1), counted)
+
+	names, err := lessThanTwo.Property(R.Name).FindStrings(nil)
+	assert.NoErr(t, err)
+	assert.Eq(t, []string{"zero"}, names)
+
+	removed, err := atLeastTwo.Remove()
+	assert.NoErr(t, err)
+	assert.Eq(t, uint64(3), removed)
+
+	ids, err = boxR.Query().FindIds()
+	assert.NoErr(t, err)
+	assert.Eq(t, []uint64{targets[0].Id}, ids)
+}
+
 func TestQueryAlias(t *testing.T) {
 	env := model.NewTestEnv(t)
 	defer env.Close()
@@ -420,10 +510,20 @@ func TestQueryAlias(t *testing.T) {
 			env.Box.Query(E.Related.Link().Alias("alias"))
 		}()
 
+		func() {
+			defer assert.MustPanic(t, regexp.MustCompile(`using Alias/As\("alias"\) on a OneToMany relation backlink is not supported`))
+			env.Box.Query(E.Related.Backlink().Alias("alias"))
+		}()
+
 		func() {
 			defer assert.MustPanic(t, regexp.MustCompile(`using Alias/As\("alias"\) on a ManyToMany relation link is not supported`))
 			env.Box.Query(E.RelatedSlice.Link().Alias("alias"))
 		}()
+
+		func() {
+			defer assert.MustPanic(t, regexp.MustCompile(`using Alias/As\("alias"\) on a ManyToMany relation backlink is not supported`))
+			env.Box.Query(E.RelatedSlice.Backlink().Alias("alias"))
+		}()
 	}
 
 	{ // As()
@@ -440,10 +540,20 @@ func TestQueryAlias(t *testing.T) {
 			env.Box.Query(E.Related.Link().As(alias))
 		}()
 
+		func() {
+			defer assert.MustPanic(t, regexp.MustCompile(`using Alias/As\("alias"\) on a OneToMany relation backlink is not supported`))
+			env.Box.Query(E.Related.Backlink().As(alias))
+		}()
+
 		func() {
 			defer assert.MustPanic(t, regexp.MustCompile(`using Alias/As\("alias"\) on a ManyToMany relation link is not supported`))
 			env.Box.Query(E.RelatedSlice.Link().As(alias))
 		}()
+
+		func() {
+			defer assert.MustPanic(t, regexp.MustCompile(`using Alias/As\("alias"\) on a ManyToMany relation backlink is not supported`))
+			env.Box.Query(E.RelatedSlice.Backlink().As(alias))
+		}()
 	}
 }
 
@@ -644,6 +754,10 @@ func TestQueryLinks(t *testing.T) {
 		{1, s{`TRUE| Backlink Entity via Related with conditions: String == "Val-1"`}, boxR.Query(E.Related.Link(E.String.Equals("", true))),
 			func(q i) error { return eq(q).SetStringParams(E.String, e.String) }},
 
+		// to-one explicit backlink, with an alias on the inner condition
+		{1, s{`TRUE| Backlink Entity via Related with conditions: String == "Val-1"`}, boxR.Query(E.Related.Backlink(E.String.Equals("", true).Alias("text"))),
+			func(q i) error { return eq(q).SetStringParams(objectbox.Alias("text"), e.String) }},
+
 		// to-one empty
 		{10, s{`TRUE| Link TestEntityRelated via Related with conditions: TRUE`}, box.Query(E.Related.Link()), nil},
 		{10, s{`TRUE| Backlink Entity via Related with conditions: TRUE`}, boxR.Query(E.Related.Link()), nil},
@@ -656,6 +770,11 @@ func TestQueryLinks(t *testing.T) {
 		{1, s{`TRUE| Backlink Entity via standalone Relation 5 (from entity 1 to 5) with conditions: String == "Val-1"`}, boxR.Query(E.RelatedPtrSlice.Link(E.String.Equals("", true))),
 			func(q i) error { return eq(q).SetStringParams(E.String, e.String) }},
 
+		// to-many explicit backlink, with an alias on the inner condition
+		{1, s{`TRUE| Backlink Entity via standalone Relation 5 (from entity 1 to 5) with conditions: String == "Val-1"`}, boxR.Query(E.RelatedPtrSlice.Backlink(E.String.Equals("", true).Alias("text"))),
+			func(q i) error { return eq(q).SetStringParams(objectbox.Alias("text"), e.String) }},
+		{10, s{`TRUE| Backlink Entity via standalone Relation 5 (from entity 1 to 5) with conditions: TRUE`}, boxR.Query(E.RelatedPtrSlice.Backlink()), nil},
+
 		// to-many empty
 		{10, s{`TRUE| Link TestEntityRelated via standalone Relation 5 (from entity 1 to 5) with conditions: TRUE`}, box.Query(E.RelatedPtrSlice.Link()), nil},
 		{10, s{`TRUE| Backlink Entity via standalone Relation 5 (from entity 1 to 5) with conditions: TRUE`}, boxR.Query(E.RelatedPtrSlice.Link()), nil},
@@ -688,6 +807,17 @@ func TestQueryLinks(t *testing.T) {
 		), nil},
 	})
 
+	// backlinks must be used in a query of the target entity
+	func() {
+		defer assert.MustPanic(t, regexp.MustCompile("relation backlink not applicable"))
+		box.Query(E.Related.Backlink())
+	}()
+
+	func() {
+		defer assert.MustPanic(t, regexp.MustCompile("relation backlink not applicable"))
+		box.Query(E.RelatedPtrSlice.Backlink())
+	}()
+
 	// ALL (explicit, inner): two to-one links and a source-entity condition
 	func() {
 		defer assert.MustPanic(t, regexp.MustCompile("using Link inside Any/All is not supported"))
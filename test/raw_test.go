@@ -0,0 +1,98 @@
+/*
+ * Copyright 2018-2021 ObjectBox Ltd. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package objectbox_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/objectbox/objectbox-go/test/assert"
+	"github.com/objectbox/objectbox-go/test/model"
+)
+
+func TestRawCopy(t *testing.T) {
+	var env = model.NewTestEnv(t)
+	defer env.Close()
+	env.Populate(10)
+
+	bytes, err := env.Box.GetRawCopy(3)
+	assert.NoErr(t, err)
+	assert.True(t, len(bytes) > 0)
+
+	// the data can be decoded using the binding
+	decoded, err := model.EntityBinding.Load(env.ObjectBox, bytes)
+	assert.NoErr(t, err)
+	object, err := env.Box.Get(3)
+	assert.NoErr(t, err)
+	assert.Eq(t, object, decoded)
+
+	bytes, err = env.Box.GetRawCopy(100)
+	assert.NoErr(t, err)
+	assert.True(t, bytes == nil)
+
+	all, err := env.Box.Query(model.Entity_.Id.GreaterThan(5)).FindRawCopy()
+	assert.NoErr(t, err)
+	assert.Eq(t, 5, len(all))
+
+	var count = 0
+	assert.NoErr(t, env.Box.VisitRaw(func(bytes []byte) bool {
+		assert.True(t, len(bytes) > 0)
+		count++
+		return count < 4
+	}))
+	assert.Eq(t, 4, count)
+
+	count = 0
+	assert.NoErr(t, env.Box.Query(model.Entity_.Id.LessOrEqual(2)).VisitRaw(func(bytes []byte) bool {
+		count++
+		return true
+	}))
+	assert.Eq(t, 2, count)
+}
+
+func TestRawZeroCopy(t *testing.T) {
+	var env = model.NewTestEnv(t)
+	defer env.Close()
+	env.Populate(10)
+
+	// zero-copy access requires a transaction
+	_, err := env.Box.GetRaw(1)
+	assert.MustMatch(t, regexp.MustCompile("requires a box bound to a transaction"), err)
+	_, err = env.Box.Query().FindRaw()
+	assert.MustMatch(t, regexp.MustCompile("requires a box bound to a transaction"), err)
+
+	tx, err := env.ObjectBox.BeginReadTx()
+	assert.NoErr(t, err)
+	defer tx.Close()
+
+	var box = tx.Box(env.Box.Box)
+
+	bytes, err := box.GetRaw(1)
+	assert.NoErr(t, err)
+	bytesCopy, err := box.GetRawCopy(1)
+	assert.NoErr(t, err)
+	assert.Eq(t, bytesCopy, bytes)
+
+	all, err := box.Query().FindRaw()
+	assert.NoErr(t, err)
+	assert.Eq(t, 10, len(all))
+	assert.Eq(t, bytesCopy, all[0])
+
+	assert.NoErr(t, tx.Close())
+	_, err = box.GetRaw(1)
+	assert.MustMatch(t, regexp.MustCompile("transaction has already been finished"), err)
+}
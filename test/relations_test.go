@@ -17,6 +17,7 @@
 package objectbox_test
 
 import (
+	"regexp"
 	"testing"
 
 	"github.com/objectbox/objectbox-go/test/assert"
@@ -259,3 +260,50 @@ func TestRelationsMissing(t *testing.T) {
 	assert.True(t, 0 == len(read.RelatedSlice))
 	assert.True(t, nil == read.RelatedPtrSlice)
 }
+
+func TestRelationsBacklinkIds(t *testing.T) {
+	var env = model.NewTestEnv(t)
+	defer env.Close()
+
+	var target = &model.TestEntityRelated{Name: "Target", NextSlice: []model.EntityByValue{}}
+	var other = &model.TestEntityRelated{Name: "Other", NextSlice: []model.EntityByValue{}}
+
+	var objects = []*model.Entity{
+		{RelatedPtr: target, RelatedPtrSlice: []*model.TestEntityRelated{target}},
+		{RelatedPtr: other, RelatedPtrSlice: []*model.TestEntityRelated{target, other}},
+		{RelatedPtr: target},
+	}
+	ids, err := env.Box.PutMany(objects)
+	assert.NoErr(t, err)
+
+	backlinkIds, err := env.Box.BacklinkIds(model.Entity_.RelatedPtr, target.Id)
+	assert.NoErr(t, err)
+	assert.Eq(t, []uint64{ids[0], ids[2]}, backlinkIds)
+
+	backlinkIds, err = env.Box.BacklinkIds(model.Entity_.RelatedPtr, other.Id)
+	assert.NoErr(t, err)
+	assert.Eq(t, []uint64{ids[1]}, backlinkIds)
+
+	backlinkIds, err = env.Box.RelationBacklinkIds(model.Entity_.RelatedPtrSlice, target.Id)
+	assert.NoErr(t, err)
+	assert.Eq(t, []uint64{ids[0], ids[1]}, backlinkIds)
+
+	backlinkIds, err = env.Box.RelationBacklinkIds(model.Entity_.RelatedPtrSlice, other.Id)
+	assert.NoErr(t, err)
+	assert.Eq(t, []uint64{ids[1]}, backlinkIds)
+
+	// no objects pointing to a new target
+	backlinkIds, err = env.Box.BacklinkIds(model.Entity_.RelatedPtr, other.Id+100)
+	assert.NoErr(t, err)
+	assert.Eq(t, 0, len(backlinkIds))
+
+	// the relations are declared by Entity, they can't be used with the box of the target entity
+	var targetBox = model.BoxForTestEntityRelated(env.ObjectBox)
+	_, err = targetBox.BacklinkIds(model.Entity_.RelatedPtr, target.Id)
+	assert.Err(t, err)
+	assert.MustMatch(t, regexp.MustCompile("relation of a different entity"), err.Error())
+
+	_, err = targetBox.RelationBacklinkIds(model.Entity_.RelatedPtrSlice, target.Id)
+	assert.Err(t, err)
+	assert.MustMatch(t, regexp.MustCompile("relation of a different entity"), err.Error())
+}
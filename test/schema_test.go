@@ -0,0 +1,84 @@
+/*
+ * Copyright 2018-2025 ObjectBox Ltd. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package objectbox_test
+
+import (
+	"testing"
+
+	"github.com/objectbox/objectbox-go/objectbox"
+	"github.com/objectbox/objectbox-go/test/assert"
+	"github.com/objectbox/objectbox-go/test/model"
+)
+
+func TestSchema(t *testing.T) {
+	env := model.NewTestEnv(t)
+	defer env.Close()
+
+	var schema = env.ObjectBox.Schema()
+	assert.True(t, schema != nil)
+	assert.Eq(t, 8, len(schema.Entities))
+	assert.Eq(t, objectbox.TypeId(8), schema.LastEntityId)
+	assert.Eq(t, uint64(1967687883385423038), schema.LastEntityUid)
+	assert.Eq(t, objectbox.TypeId(4), schema.LastIndexId)
+	assert.Eq(t, objectbox.TypeId(6), schema.LastRelationId)
+
+	assert.True(t, schema.Entity("Missing") == nil)
+	assert.True(t, schema.EntityById(42) == nil)
+
+	var entity = schema.Entity("Entity")
+	assert.True(t, entity != nil)
+	assert.Eq(t, entity, schema.EntityById(model.EntityBinding.Id))
+	assert.Eq(t, model.EntityBinding.Id, entity.Id)
+	assert.Eq(t, model.EntityBinding.Uid, entity.Uid)
+	assert.Eq(t, objectbox.TypeId(44), entity.LastPropertyId)
+	assert.Eq(t, uint64(6100401720382402484), entity.LastPropertyUid)
+
+	var id = entity.IdProperty()
+	assert.True(t, id != nil)
+	assert.Eq(t, "Id", id.Name)
+	assert.Eq(t, objectbox.PropertyTypeLong, id.Type)
+	assert.True(t, id.HasFlag(objectbox.PropertyFlagId))
+
+	var prop = entity.Property("String")
+	assert.True(t, prop != nil)
+	assert.Eq(t, model.Entity_.String.Id, prop.Id)
+	assert.Eq(t, objectbox.PropertyTypeString, prop.Type)
+	assert.Eq(t, 0, prop.Flags)
+	assert.Eq(t, prop, entity.PropertyById(prop.Id))
+	assert.True(t, entity.Property("Missing") == nil)
+
+	prop = entity.Property("Uint64")
+	assert.True(t, prop.HasFlag(objectbox.PropertyFlagUnsigned))
+	assert.True(t, !prop.HasFlag(objectbox.PropertyFlagId))
+
+	prop = entity.Property("Related")
+	assert.Eq(t, objectbox.PropertyTypeRelation, prop.Type)
+	assert.True(t, prop.HasFlag(objectbox.PropertyFlagIndexed))
+	assert.Eq(t, "TestEntityRelated", prop.RelationTarget)
+	assert.Eq(t, objectbox.TypeId(1), prop.IndexId)
+	assert.Eq(t, uint64(7297830522090799401), prop.IndexUid)
+
+	assert.Eq(t, 2, len(entity.Relations))
+	assert.Eq(t, objectbox.TypeId(4), entity.Relations[0].Id)
+	assert.Eq(t, uint64(5379891792880176678), entity.Relations[0].Uid)
+	assert.Eq(t, model.EntityByValueBinding.Id, entity.Relations[0].TargetEntityId)
+	assert.Eq(t, model.TestEntityRelatedBinding.Id, entity.Relations[1].TargetEntityId)
+
+	var synced = schema.Entity("TestEntitySynced")
+	assert.True(t, synced != nil)
+	assert.Eq(t, objectbox.EntityFlagSyncEnabled, synced.Flags&objectbox.EntityFlagSyncEnabled)
+}
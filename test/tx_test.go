@@ -18,9 +18,11 @@ package objectbox_test
 
 import (
 	"errors"
+	"regexp"
 	"testing"
 
 	"github.com/objectbox/objectbox-go/test/assert"
+	"github.com/objectbox/objectbox-go/test/model"
 	"github.com/objectbox/objectbox-go/test/model/iot"
 )
 
@@ -93,3 +95,121 @@ func TestTransactionRollback(t *testing.T) {
 	assert.Eq(t, 0, int(count))
 
 }
+
+func TestTxCommit(t *testing.T) {
+	env := model.NewTestEnv(t)
+	defer env.Close()
+
+	tx, err := env.ObjectBox.BeginWriteTx()
+	assert.NoErr(t, err)
+	assert.True(t, tx.IsActive())
+	assert.True(t, !tx.IsReadOnly())
+
+	var box = &model.EntityBox{Box: tx.Box(env.Box.Box)}
+	id, err := box.Put(model.Entity47())
+	assert.NoErr(t, err)
+
+	// the query is bound to the transaction as well
+	count, err := box.Query(model.Entity_.Id.Equals(id)).Count()
+	assert.NoErr(t, err)
+	assert.Eq(t, uint64(1), count)
+
+	assert.NoErr(t, tx.Commit())
+	assert.True(t, !tx.IsActive())
+	assert.NoErr(t, tx.Close()) // no-op on a finished transaction
+
+	count, err = env.Box.Count()
+	assert.NoErr(t, err)
+	assert.Eq(t, uint64(1), count)
+
+	// the bound box can't be used anymore
+	_, err = box.Count()
+	assert.Eq(t, "illegal state; the transaction has already been finished", err.Error())
+	assert.Err(t, tx.Commit())
+}
+
+func TestTxAbort(t *testing.T) {
+	env := model.NewTestEnv(t)
+	defer env.Close()
+
+	// explicit abort
+	tx, err := env.ObjectBox.BeginWriteTx()
+	assert.NoErr(t, err)
+	_, err = tx.Box(env.Box.Box).Put(model.Entity47())
+	assert.NoErr(t, err)
+	assert.NoErr(t, tx.Abort())
+
+	count, err := env.Box.Count()
+	assert.NoErr(t, err)
+	assert.Eq(t, uint64(0), count)
+
+	// close without a commit
+	func() {
+		tx, err := env.ObjectBox.BeginWriteTx()
+		assert.NoErr(t, err)
+		defer tx.Close()
+
+		_, err = tx.Box(env.Box.Box).Put(model.Entity47())
+		assert.NoErr(t, err)
+	}()
+
+	count, err = env.Box.Count()
+	assert.NoErr(t, err)
+	assert.Eq(t, uint64(0), count)
+}
+
+func TestTxRead(t *testing.T) {
+	env := model.NewTestEnv(t)
+	defer env.Close()
+
+	id, err := env.Box.Put(model.Entity47())
+	assert.NoErr(t, err)
+
+	tx, err := env.ObjectBox.BeginReadTx()
+	assert.NoErr(t, err)
+	defer tx.Close()
+	assert.True(t, tx.IsReadOnly())
+
+	var box = &model.EntityBox{Box: tx.Box(env.Box.Box)}
+	object, err := box.Get(id)
+	assert.NoErr(t, err)
+	assert.Eq(t, id, object.Id)
+
+	_, err = box.Put(model.Entity47())
+	assert.Eq(t, "illegal state; cannot write in a read transaction", err.Error())
+
+	_, err = box.Query().Remove()
+	assert.Eq(t, "illegal state; cannot write in a read transaction", err.Error())
+
+	assert.NoErr(t, tx.Commit())
+}
+
+func TestTxOtherGoroutine(t *testing.T) {
+	env := model.NewTestEnv(t)
+	defer env.Close()
+
+	tx, err := env.ObjectBox.BeginReadTx()
+	assert.NoErr(t, err)
+	defer tx.Close()
+
+	var box = tx.Box(env.Box.Box)
+
+	var errs = make(chan error, 3)
+	go func() {
+		_, err := box.Count()
+		errs <- err
+		_, err = box.Query().Find()
+		errs <- err
+		errs <- tx.Commit()
+	}()
+
+	for i := 0; i < 3; i++ {
+		var err = <-errs
+		assert.Err(t, err)
+		assert.MustMatch(t, regexp.MustCompile("used from another goroutine"), err.Error())
+	}
+
+	assert.True(t, tx.IsActive())
+	_, err = box.Count()
+	assert.NoErr(t, err)
+}
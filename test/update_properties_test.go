@@ -0,0 +1,87 @@
+/*
+ * Copyright 2018-2021 ObjectBox Ltd. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package objectbox_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/objectbox/objectbox-go/objectbox"
+	"github.com/objectbox/objectbox-go/test/assert"
+	"github.com/objectbox/objectbox-go/test/model"
+)
+
+func TestBoxUpdateProperties(t *testing.T) {
+	var env = model.NewTestEnv(t)
+	defer env.Close()
+
+	var object = model.Entity47()
+	id, err := env.Box.Put(object)
+	assert.NoErr(t, err)
+
+	assert.NoErr(t, env.Box.UpdateProperties(id, model.Entity_.String.Set("changed"), model.Entity_.Int64.Set(1),
+		model.Entity_.Bool.Set(false)))
+
+	object.String = "changed"
+	object.Int64 = 1
+	object.Bool = false
+
+	read, err := env.Box.Get(id)
+	assert.NoErr(t, err)
+	assert.Eq(t, object, read)
+
+	// removing a value
+	assert.NoErr(t, env.Box.UpdateProperties(id, model.Entity_.String.SetNil()))
+	read, err = env.Box.Get(id)
+	assert.NoErr(t, err)
+	assert.Eq(t, "", read.String)
+	assert.Eq(t, int64(1), read.Int64)
+
+	// errors
+	err = env.Box.UpdateProperties(id+1, model.Entity_.String.Set("missing"))
+	assertErrorIs(t, err, objectbox.ErrNotFound)
+
+	err = env.Box.UpdateProperties(id, model.TestStringIdEntity_.Id.Set(1))
+	assert.MustMatch(t, regexp.MustCompile("property from a different entity"), err)
+
+	err = env.Box.UpdateProperties(id, model.Entity_.Id.Set(1))
+	assert.MustMatch(t, regexp.MustCompile("can't change the ID property"), err)
+}
+
+func TestQueryUpdate(t *testing.T) {
+	var env = model.NewTestEnv(t)
+	defer env.Close()
+	env.Populate(10)
+
+	var query = env.Box.Query(model.Entity_.Id.GreaterThan(6))
+	count, err := query.Update(model.Entity_.String.Set("updated"), model.Entity_.Int32.Set(-1))
+	assert.NoErr(t, err)
+	assert.Eq(t, uint64(4), count)
+
+	objects, err := env.Box.Query(model.Entity_.String.Equals("updated", true)).Find()
+	assert.NoErr(t, err)
+	assert.Eq(t, 4, len(objects))
+	for _, object := range objects {
+		assert.True(t, object.Id > 6)
+		assert.Eq(t, int32(-1), object.Int32)
+	}
+
+	// no matches
+	count, err = env.Box.Query(model.Entity_.Id.GreaterThan(100)).Update(model.Entity_.String.Set("none"))
+	assert.NoErr(t, err)
+	assert.Eq(t, uint64(0), count)
+}
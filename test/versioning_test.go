@@ -0,0 +1,176 @@
+/*
+ * Copyright 2018-2021 ObjectBox Ltd. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package objectbox_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/objectbox/objectbox-go/objectbox"
+	"github.com/objectbox/objectbox-go/test/assert"
+	"github.com/objectbox/objectbox-go/test/model"
+)
+
+func versionedStore(t *testing.T, name string) *objectbox.ObjectBox {
+	var m = model.ObjectBoxModel()
+	m.VersionProperty("Entity", "Int64")
+	ob, err := objectbox.NewBuilder().Directory("memory:" + name).Model(m).BuildOrError()
+	assert.NoErr(t, err)
+	return ob
+}
+
+func assertVersionConflict(t *testing.T, err error, stored int64) {
+	assert.Err(t, err)
+	conflict, ok := err.(*objectbox.VersionConflictError)
+	if !ok {
+		assert.Failf(t, "expected a *VersionConflictError, got %T: %v", err, err)
+	}
+	assert.True(t, conflict.Is(objectbox.ErrVersionConflict))
+	assert.Eq(t, stored, conflict.StoredVersion)
+}
+
+func TestVersionPropertyModel(t *testing.T) {
+	var m = model.ObjectBoxModel()
+	m.VersionProperty("Entity", "Missing")
+	assert.MustMatch(t, regexp.MustCompile("property Entity.Missing not found"), m.Error)
+
+	m = model.ObjectBoxModel()
+	m.VersionProperty("Entity", "String")
+	assert.MustMatch(t, regexp.MustCompile("must be an integer"), m.Error)
+
+	m = model.ObjectBoxModel()
+	m.VersionProperty("Entity", "Id")
+	assert.MustMatch(t, regexp.MustCompile("is the ID property"), m.Error)
+
+	m = model.ObjectBoxModel()
+	m.VersionProperty("Missing", "Int64")
+	assert.MustMatch(t, regexp.MustCompile("entity Missing not found"), m.Error)
+}
+
+func TestVersionPropertyPut(t *testing.T) {
+	var ob = versionedStore(t, "version-put")
+	defer ob.Close()
+	var box = model.BoxForEntity(ob)
+
+	var object = model.Entity47()
+	object.Int64 = 0
+	id, err := box.Put(object)
+	assert.NoErr(t, err)
+	assert.Eq(t, int64(1), object.Int64)
+
+	// two "editors" reading the same version
+	first, err := box.Get(id)
+	assert.NoErr(t, err)
+	second, err := box.Get(id)
+	assert.NoErr(t, err)
+
+	first.String = "first"
+	assert.NoErr(t, box.Update(first))
+	assert.Eq(t, int64(2), first.Int64)
+
+	second.String = "second"
+	assertVersionConflict(t, box.Update(second), 2)
+	assert.Eq(t, int64(1), second.Int64)
+
+	_, err = box.Put(second)
+	assertVersionConflict(t, err, 2)
+
+	read, err := box.Get(id)
+	assert.NoErr(t, err)
+	assert.Eq(t, "first", read.String)
+	assert.Eq(t, int64(2), read.Int64)
+
+	// the object was removed in the meantime
+	assert.NoErr(t, box.RemoveId(id))
+	assertVersionConflict(t, box.Update(read), 0)
+
+	// partial updates increment the version, too
+	id, err = box.Put(&model.Entity{String: "partial"})
+	assert.NoErr(t, err)
+	assert.NoErr(t, box.UpdateProperties(id, model.Entity_.String.Set("changed")))
+	read, err = box.Get(id)
+	assert.NoErr(t, err)
+	assert.Eq(t, int64(2), read.Int64)
+
+	// async puts can't check the version
+	_, err = box.Async().Put(&model.Entity{})
+	assert.MustMatch(t, regexp.MustCompile("version property"), err)
+}
+
+func TestVersionPropertyPutMany(t *testing.T) {
+	var ob = versionedStore(t, "version-put-many")
+	defer ob.Close()
+	var box = model.BoxForEntity(ob)
+
+	var objects = []*model.Entity{{String: "a"}, {String: "b"}, {String: "c"}}
+	ids, err := box.PutMany(objects)
+	assert.NoErr(t, err)
+	for _, object := range objects {
+		assert.Eq(t, int64(1), object.Int64)
+	}
+
+	stale, err := box.Get(ids[1])
+	assert.NoErr(t, err)
+	assert.NoErr(t, box.Update(objects[1]))
+
+	// a single conflict aborts PutMany() and reverts the versions on all objects
+	_, err = box.PutMany([]*model.Entity{objects[0], stale})
+	assertVersionConflict(t, err, 2)
+	assert.Eq(t, int64(1), objects[0].Int64)
+	assert.Eq(t, int64(1), stale.Int64)
+
+	// ... while UpdateMany() reports it as a failure and stores the other objects
+	err = box.UpdateMany([]*model.Entity{objects[0], stale, objects[2]})
+	assert.Err(t, err)
+	var failures = err.(*objectbox.PutManyError).Failures
+	assert.Eq(t, 1, len(failures))
+	assertVersionConflict(t, failures[1], 2)
+	assert.Eq(t, int64(2), objects[0].Int64)
+	assert.Eq(t, int64(2), objects[2].Int64)
+}
+
+func TestVersionPropertyDynamicBox(t *testing.T) {
+	var ob = versionedStore(t, "version-dynamic")
+	defer ob.Close()
+
+	box, err := ob.DynamicBox("Entity")
+	assert.NoErr(t, err)
+
+	var object = map[string]interface{}{"String": "dynamic"}
+	id, err := box.Put(object)
+	assert.NoErr(t, err)
+	assert.Eq(t, int64(1), object["Int64"])
+
+	first, err := box.Get(id)
+	assert.NoErr(t, err)
+	second, err := box.Get(id)
+	assert.NoErr(t, err)
+
+	first["String"] = "first"
+	assert.NoErr(t, box.Update(first))
+	assert.Eq(t, int64(2), first["Int64"])
+
+	// a DynamicBox checks the version the same way as the typed box
+	second["String"] = "second"
+	assertVersionConflict(t, box.Update(second), 2)
+	assert.Eq(t, int64(1), second["Int64"])
+
+	read, err := model.BoxForEntity(ob).Get(id)
+	assert.NoErr(t, err)
+	assert.Eq(t, "first", read.String)
+	assert.Eq(t, int64(2), read.Int64)
+}